@@ -65,6 +65,7 @@ func initCommands(ui cli.Ui) map[string]cli.CommandFactory {
 		"generate resources":    commandFactory(&cmd.GenerateResourcesCommand{UI: ui}),
 		"generate data-sources": commandFactory(&cmd.GenerateDataSourcesCommand{UI: ui}),
 		"generate provider":     commandFactory(&cmd.GenerateProviderCommand{UI: ui}),
+		"generate sdk":          commandFactory(&cmd.GenerateSDKCommand{UI: ui}),
 		// Code scaffolding commands
 		"scaffold":             commandFactory(&cmd.ScaffoldCommand{UI: ui}),
 		"scaffold resource":    commandFactory(&cmd.ScaffoldResourceCommand{UI: ui}),
@@ -24,6 +24,8 @@ type GenerateAllCommand struct {
 	flagOutputPath  string
 	flagPackageName string
 	flagGenRefresh  bool
+	flagGenMock     bool
+	flagImportPath  string
 }
 
 func (cmd *GenerateAllCommand) Flags() *flag.FlagSet {
@@ -32,6 +34,8 @@ func (cmd *GenerateAllCommand) Flags() *flag.FlagSet {
 	fs.StringVar(&cmd.flagOutputPath, "output", "./output", "directory path to output generated code files")
 	fs.StringVar(&cmd.flagPackageName, "package", "", "name of Go package for generated code files")
 	fs.BoolVar(&cmd.flagGenRefresh, "gen_refresh", false, "whether render new refresh files or not")
+	fs.BoolVar(&cmd.flagGenMock, "gen_mock", false, "whether render unit tests against a mock API server or not")
+	fs.StringVar(&cmd.flagImportPath, "import_path", "", "Go import path of the output directory, detected from go.mod when omitted")
 
 	return fs
 }
@@ -120,15 +124,32 @@ func (cmd *GenerateAllCommand) runInternal(ctx context.Context, logger *slog.Log
 		return fmt.Errorf("error parsing IR JSON: %w", err)
 	}
 
-	err = generateDataSourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "DataSource", cmd.flagGenRefresh, logger)
+	// generated code imports the SDK from the output directory
+	sdkImportPath, err := ncloud.SDKImportPath(cmd.flagOutputPath, cmd.flagImportPath)
+	if err != nil {
+		return fmt.Errorf("error resolving the import path of the SDK, set --import_path: %w", err)
+	}
+
+	err = generateDataSourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, sdkImportPath, "DataSource", cmd.flagGenRefresh, logger)
 	if err != nil {
 		return fmt.Errorf("error generating data source code: %w", err)
 	}
 
-	err = generateResourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "Resource", cmd.flagGenRefresh, logger)
+	err = generateResourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, sdkImportPath, "Resource", cmd.flagGenRefresh, cmd.flagGenMock, logger)
 	if err != nil {
 		return fmt.Errorf("error generating resource code: %w", err)
 	}
 
+	// generated code imports the SDK from <output>/ncloudsdk, so it is written along with it
+	err = ncloud.WriteNcloudSDK(spec, cmd.flagOutputPath, ncloud.SDKPackageName)
+	if err != nil {
+		return fmt.Errorf("error generating SDK code: %w", err)
+	}
+
+	err = ncloud.WriteNcloudRegistry(spec, cmd.flagOutputPath, cmd.flagPackageName, cmd.flagImportPath)
+	if err != nil {
+		return fmt.Errorf("error generating registry: %w", err)
+	}
+
 	return nil
 }
@@ -4,6 +4,7 @@
 package cmd_test
 
 import (
+	"path"
 	"testing"
 
 	"github.com/hashicorp/cli"
@@ -34,7 +35,8 @@ func TestGenerateAllCommand(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			testOutputDir := t.TempDir()
+			// The registry is named after the output directory.
+			testOutputDir := path.Join(t.TempDir(), "provider")
 			mockUi := cli.NewMockUi()
 			c := cmd.GenerateAllCommand{
 				UI: mockUi,
@@ -44,6 +46,7 @@ func TestGenerateAllCommand(t *testing.T) {
 				"--input", testCase.irInputPath,
 				"--package", testCase.pkgName,
 				"--output", testOutputDir,
+				"--import_path", "example.com/generated/provider",
 			}
 
 			exitCode := c.Run(args)
@@ -28,6 +28,7 @@ type GenerateDataSourcesCommand struct {
 	flagOutputPath  string
 	flagPackageName string
 	flagGenRefresh  bool
+	flagImportPath  string
 }
 
 func (cmd *GenerateDataSourcesCommand) Flags() *flag.FlagSet {
@@ -36,6 +37,7 @@ func (cmd *GenerateDataSourcesCommand) Flags() *flag.FlagSet {
 	fs.StringVar(&cmd.flagOutputPath, "output", "./output", "directory path to output generated code files")
 	fs.StringVar(&cmd.flagPackageName, "package", "", "name of Go package for generated code files")
 	fs.BoolVar(&cmd.flagGenRefresh, "gen_refresh", false, "whether render new refresh files or not")
+	fs.StringVar(&cmd.flagImportPath, "import_path", "", "Go import path of the output directory, detected from go.mod when omitted")
 
 	return fs
 }
@@ -124,7 +126,13 @@ func (cmd *GenerateDataSourcesCommand) runInternal(ctx context.Context, logger *
 		return fmt.Errorf("error parsing IR JSON: %w", err)
 	}
 
-	err = generateDataSourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "DataSource", cmd.flagGenRefresh, logger)
+	// generated code imports the SDK from the output directory
+	sdkImportPath, err := ncloud.SDKImportPath(cmd.flagOutputPath, cmd.flagImportPath)
+	if err != nil {
+		return fmt.Errorf("error resolving the import path of the SDK, set --import_path: %w", err)
+	}
+
+	err = generateDataSourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, sdkImportPath, "DataSource", cmd.flagGenRefresh, logger)
 	if err != nil {
 		return fmt.Errorf("error generating data source code: %w", err)
 	}
@@ -132,7 +140,7 @@ func (cmd *GenerateDataSourcesCommand) runInternal(ctx context.Context, logger *
 	return nil
 }
 
-func generateDataSourceCode(ctx context.Context, spec util.NcloudSpecification, outputPath, packageName, generatorType string, genRefresh bool, logger *slog.Logger) error {
+func generateDataSourceCode(ctx context.Context, spec util.NcloudSpecification, outputPath, packageName, sdkImportPath, generatorType string, genRefresh bool, logger *slog.Logger) error {
 	// ctxWithPath := logging.SetPathInContext(ctx, "data_source")
 
 	// convert IR to framework schemas
@@ -141,8 +149,10 @@ func generateDataSourceCode(ctx context.Context, spec util.NcloudSpecification,
 		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", err)
 	}
 
-	// convert framework schema to []byte
-	g := schema.NewGeneratorSchemas(s)
+	// convert framework schema to []byte, without custom types as the NCLOUD code uses framework types
+	g := schema.NewGeneratorSchemas(s, schema.SchemaOptions{
+		OmitCustomTypes: true,
+	})
 	schemas, err := g.Schemas(packageName, generatorType)
 	if err != nil {
 		return fmt.Errorf("error converting Plugin Framework schema to Go code: %w", err)
@@ -157,19 +167,19 @@ func generateDataSourceCode(ctx context.Context, spec util.NcloudSpecification,
 	// --- NCLOUD Logic ---
 
 	// write code
-	err = ncloud.WriteNcloudDataSources(formattedSchemas, spec, outputPath, packageName)
+	err = ncloud.WriteNcloudDataSources(formattedSchemas, spec, outputPath, packageName, sdkImportPath)
 	if err != nil {
 		return fmt.Errorf("error writing Go code to output: %w", err)
 	}
 
-	err = ncloud.WriteNcloudDataSourceTests(formattedSchemas, spec, outputPath, packageName)
+	err = ncloud.WriteNcloudDataSourceTests(formattedSchemas, spec, outputPath, packageName, sdkImportPath)
 	if err != nil {
 		return fmt.Errorf("error writing Go code to output: %w", err)
 	}
 
 	// Render refresh file conditionally
 	if genRefresh {
-		err = ncloud.WriteNcloudDataSourceRefresh(formattedSchemas, spec, outputPath, packageName)
+		err = ncloud.WriteNcloudDataSourceRefresh(formattedSchemas, spec, outputPath, packageName, sdkImportPath)
 		if err != nil {
 			return fmt.Errorf("error writing Go code to output: %w", err)
 		}
@@ -37,6 +37,7 @@ func TestGenerateDataSourcesCommand(t *testing.T) {
 				"--input", testCase.irInputPath,
 				"--package", "generated",
 				"--output", testOutputDir,
+				"--import_path", "example.com/generated",
 			}
 
 			exitCode := c.Run(args)
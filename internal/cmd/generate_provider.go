@@ -142,8 +142,10 @@ func generateProviderCode(ctx context.Context, spec util.NcloudSpecification, ou
 		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", err)
 	}
 
-	// convert framework schema to []byte
-	g := schema.NewGeneratorSchemas(s)
+	// convert framework schema to []byte, without CustomType fields like resources and data sources
+	g := schema.NewGeneratorSchemas(s, schema.SchemaOptions{
+		OmitCustomTypes: true,
+	})
 	schemas, err := g.Schemas(packageName, generatorType)
 	if err != nil {
 		return fmt.Errorf("error converting Plugin Framework schema to Go code: %w", err)
@@ -25,6 +25,10 @@ func TestGenerateProviderCommand(t *testing.T) {
 			irInputPath:   "testdata/provider_no_attributes/ir.json",
 			goldenFileDir: "testdata/provider_no_attributes/provider_output",
 		},
+		"type_mapping": {
+			irInputPath:   "testdata/type_mapping/ir.json",
+			goldenFileDir: "testdata/type_mapping/provider_output",
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
@@ -29,6 +29,8 @@ type GenerateResourcesCommand struct {
 	flagOutputPath  string
 	flagPackageName string
 	flagGenRefresh  bool
+	flagGenMock     bool
+	flagImportPath  string
 }
 
 func (cmd *GenerateResourcesCommand) Flags() *flag.FlagSet {
@@ -37,6 +39,8 @@ func (cmd *GenerateResourcesCommand) Flags() *flag.FlagSet {
 	fs.StringVar(&cmd.flagOutputPath, "output", "./output", "directory path to output generated code files")
 	fs.StringVar(&cmd.flagPackageName, "package", "", "name of Go package for generated code files")
 	fs.BoolVar(&cmd.flagGenRefresh, "gen_refresh", false, "whether render new refresh files or not")
+	fs.BoolVar(&cmd.flagGenMock, "gen_mock", false, "whether render unit tests against a mock API server or not")
+	fs.StringVar(&cmd.flagImportPath, "import_path", "", "Go import path of the output directory, detected from go.mod when omitted")
 
 	return fs
 }
@@ -125,7 +129,13 @@ func (cmd *GenerateResourcesCommand) runInternal(ctx context.Context, logger *sl
 		return fmt.Errorf("error parsing IR JSON: %w", err)
 	}
 
-	err = generateResourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, "Resource", cmd.flagGenRefresh, logger)
+	// generated code imports the SDK from the output directory
+	sdkImportPath, err := ncloud.SDKImportPath(cmd.flagOutputPath, cmd.flagImportPath)
+	if err != nil {
+		return fmt.Errorf("error resolving the import path of the SDK, set --import_path: %w", err)
+	}
+
+	err = generateResourceCode(ctx, spec, cmd.flagOutputPath, cmd.flagPackageName, sdkImportPath, "Resource", cmd.flagGenRefresh, cmd.flagGenMock, logger)
 	if err != nil {
 		return fmt.Errorf("error generating resource code: %w", err)
 	}
@@ -133,17 +143,25 @@ func (cmd *GenerateResourcesCommand) runInternal(ctx context.Context, logger *sl
 	return nil
 }
 
-func generateResourceCode(ctx context.Context, spec util.NcloudSpecification, outputPath, packageName, generatorType string, genRefresh bool, logger *slog.Logger) error {
+func generateResourceCode(ctx context.Context, spec util.NcloudSpecification, outputPath, packageName, sdkImportPath, generatorType string, genRefresh, genMock bool, logger *slog.Logger) error {
 	ctx = logging.SetPathInContext(ctx, "resource")
 
+	// replace resources when attributes which can not be updated change
+	err := ncloud.AddRequiresReplace(&spec)
+	if err != nil {
+		return fmt.Errorf("error adding plan modifiers: %w", err)
+	}
+
 	// convert IR to framework schema
 	s, err := ncloud_resource.NewSchemas(spec)
 	if err != nil {
 		return fmt.Errorf("error converting IR to Plugin Framework schema: %w", err)
 	}
 
-	// convert framework schema to []byte
-	g := schema.NewGeneratorSchemas(s)
+	// convert framework schema to []byte, without custom types as the NCLOUD code uses framework types
+	g := schema.NewGeneratorSchemas(s, schema.SchemaOptions{
+		OmitCustomTypes: true,
+	})
 	schemas, err := g.Schemas(packageName, generatorType)
 	if err != nil {
 		return fmt.Errorf("error converting Plugin Framework schema to Go code: %w", err)
@@ -158,19 +176,27 @@ func generateResourceCode(ctx context.Context, spec util.NcloudSpecification, ou
 	// --- NCLOUD Logic ---
 
 	// write code
-	err = ncloud.WriteNcloudResources(formattedSchemas, spec, outputPath, packageName, genRefresh)
+	err = ncloud.WriteNcloudResources(formattedSchemas, spec, outputPath, packageName, sdkImportPath)
 	if err != nil {
 		return fmt.Errorf("error writing Go code to output: %w", err)
 	}
 
-	err = ncloud.WriteNcloudResourceTests(formattedSchemas, spec, outputPath, packageName)
+	err = ncloud.WriteNcloudResourceTests(formattedSchemas, spec, outputPath, packageName, sdkImportPath)
 	if err != nil {
 		return fmt.Errorf("error writing Go code to output: %w", err)
 	}
 
 	// Render refresh file conditionally
 	if genRefresh {
-		err = ncloud.WriteNcloudResourceRefresh(formattedSchemas, spec, outputPath, packageName)
+		err = ncloud.WriteNcloudResourceRefresh(formattedSchemas, spec, outputPath, packageName, sdkImportPath)
+		if err != nil {
+			return fmt.Errorf("error writing Go code to output: %w", err)
+		}
+	}
+
+	// Render unit tests against a mock API server conditionally
+	if genMock {
+		err = ncloud.WriteNcloudResourceMockTests(formattedSchemas, spec, outputPath, packageName, sdkImportPath)
 		if err != nil {
 			return fmt.Errorf("error writing Go code to output: %w", err)
 		}
@@ -16,11 +16,23 @@ func TestGenerateResourcesCommand(t *testing.T) {
 	testCases := map[string]struct {
 		irInputPath   string
 		goldenFileDir string
+		args          []string
 	}{
 		"custom_and_external": {
 			irInputPath:   "testdata/custom_and_external/ir.json",
 			goldenFileDir: "testdata/custom_and_external/resources_output",
 		},
+		// Copied into internal/generated of the provider along with the SDK of TestGenerateSDKCommand,
+		// the output compiles and its mock test passes.
+		"ncloud_mock": {
+			irInputPath:   "testdata/ncloud_mock/ir.json",
+			goldenFileDir: "testdata/ncloud_mock/resources_output",
+			args: []string{
+				"--import_path", "github.com/terraform-providers/terraform-provider-ncloud/internal/generated",
+				"--gen_refresh",
+				"--gen_mock",
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
@@ -37,7 +49,9 @@ func TestGenerateResourcesCommand(t *testing.T) {
 				"--input", testCase.irInputPath,
 				"--package", "generated",
 				"--output", testOutputDir,
+				"--import_path", "example.com/generated",
 			}
+			args = append(args, testCase.args...)
 
 			exitCode := c.Run(args)
 			if exitCode != 0 {
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/input"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/ncloud"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/validate"
+)
+
+type GenerateSDKCommand struct {
+	UI              cli.Ui
+	flagIRInputPath string
+	flagOutputPath  string
+}
+
+func (cmd *GenerateSDKCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("generate sdk", flag.ExitOnError)
+	fs.StringVar(&cmd.flagIRInputPath, "input", "./ir.json", "path to intermediate representation (JSON)")
+	fs.StringVar(&cmd.flagOutputPath, "output", "./output", "directory path to output generated code files")
+
+	return fs
+}
+
+func (cmd *GenerateSDKCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-framework generate sdk [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (a *GenerateSDKCommand) Synopsis() string {
+	return "Generate the NCloud SDK client called by resources and data sources from an Intermediate Representation (IR) JSON file."
+}
+
+func (cmd *GenerateSDKCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("error parsing command flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal(ctx, logger)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *GenerateSDKCommand) runInternal(ctx context.Context, logger *slog.Logger) error {
+	// read input file
+	src, err := input.Read(cmd.flagIRInputPath)
+	if err != nil {
+		return fmt.Errorf("error reading IR JSON: %w", err)
+	}
+
+	// validate JSON
+	err = validate.JSON(src)
+	if err != nil {
+		return fmt.Errorf("error validating IR JSON: %w", err)
+	}
+
+	// parse and validate IR against specification
+	spec, err := ncloud.NcloudParse(ctx, src)
+	if err != nil {
+		return fmt.Errorf("error parsing IR JSON: %w", err)
+	}
+
+	err = generateSDKCode(ctx, spec, cmd.flagOutputPath, logger)
+	if err != nil {
+		return fmt.Errorf("error generating SDK code: %w", err)
+	}
+
+	return nil
+}
+
+func generateSDKCode(ctx context.Context, spec util.NcloudSpecification, outputPath string, logger *slog.Logger) error {
+	// write code into <output>/ncloudsdk, the package which generated resources and data sources import
+	err := ncloud.WriteNcloudSDK(spec, outputPath, ncloud.SDKPackageName)
+	if err != nil {
+		return fmt.Errorf("error writing Go code to output: %w", err)
+	}
+
+	return nil
+}
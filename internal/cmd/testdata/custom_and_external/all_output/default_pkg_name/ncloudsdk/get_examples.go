@@ -0,0 +1,42 @@
+package ncloudsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PrimitiveGETExamplesRequest struct {
+	BoolAttribute *bool `json:"boolAttribute,omitempty"`
+}
+
+type PrimitiveGETExamplesResponse struct {
+	Example types.Object
+}
+
+// GETExamples_TF calls GET /examples
+func (c *Client) GETExamples_TF(ctx context.Context, r *PrimitiveGETExamplesRequest) (*PrimitiveGETExamplesResponse, error) {
+	path := expandPath("/examples", map[string]interface{}{})
+
+	query := map[string]interface{}{}
+
+	if r.BoolAttribute != nil {
+		query["boolAttribute"] = *r.BoolAttribute
+	}
+
+	body := map[string]interface{}{}
+
+	data, err := c.do(ctx, "GET", path, query, body)
+	if err != nil {
+		return nil, notFound(err, []int{404}, []string{})
+	}
+
+	response := &PrimitiveGETExamplesResponse{}
+
+	response.Example, err = toObject(responseObject(data, "example"))
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,40 @@
+package ncloudsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PrimitiveGETExamplesExampleidRequest struct {
+	Exampleid string `json:"example-id"`
+}
+
+type PrimitiveGETExamplesExampleidResponse struct {
+	Example types.Object
+}
+
+// GETExamplesExampleid_TF calls GET /examples/{example-id}
+func (c *Client) GETExamplesExampleid_TF(ctx context.Context, r *PrimitiveGETExamplesExampleidRequest) (*PrimitiveGETExamplesExampleidResponse, error) {
+	path := expandPath("/examples/{example-id}", map[string]interface{}{
+		"example-id": r.Exampleid,
+	})
+
+	query := map[string]interface{}{}
+
+	body := map[string]interface{}{}
+
+	data, err := c.do(ctx, "GET", path, query, body)
+	if err != nil {
+		return nil, notFound(err, []int{404}, []string{})
+	}
+
+	response := &PrimitiveGETExamplesExampleidResponse{}
+
+	response.Example, err = toObject(responseObject(data, "example"))
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,36 @@
+package ncloudsdk
+
+import (
+	"context"
+)
+
+type PrimitivePOSTExamplesRequest struct {
+	BoolAttribute *bool `json:"boolAttribute,omitempty"`
+
+	// NullFields are the names of optional body fields sent as null, to clear their value.
+	NullFields []string `json:"-"`
+}
+
+// POSTExamples_TF calls POST /examples
+func (c *Client) POSTExamples_TF(ctx context.Context, r *PrimitivePOSTExamplesRequest) (map[string]interface{}, error) {
+	path := expandPath("/examples", map[string]interface{}{})
+
+	query := map[string]interface{}{}
+
+	body := map[string]interface{}{}
+
+	if r.BoolAttribute != nil {
+		body["boolAttribute"] = *r.BoolAttribute
+	}
+
+	for _, name := range r.NullFields {
+		body[name] = nil
+	}
+
+	data, err := c.do(ctx, "POST", path, query, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,27 @@
+package ncloudsdk
+
+import (
+	"context"
+)
+
+type PrimitiveDELETEExamplesExampleidRequest struct {
+	Exampleid string `json:"example-id"`
+}
+
+// DELETEExamplesExampleid_TF calls DELETE /examples/{example-id}
+func (c *Client) DELETEExamplesExampleid_TF(ctx context.Context, r *PrimitiveDELETEExamplesExampleidRequest) (map[string]interface{}, error) {
+	path := expandPath("/examples/{example-id}", map[string]interface{}{
+		"example-id": r.Exampleid,
+	})
+
+	query := map[string]interface{}{}
+
+	body := map[string]interface{}{}
+
+	data, err := c.do(ctx, "DELETE", path, query, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,37 @@
+package generated_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-codegen-poc/internal/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNcloudExample_example_basic(t *testing.T) {
+	exampleName := fmt.Sprintf("tf-example-%s", acctest.RandString(5))
+
+	datasourceName := "ncloud_example_example.testing_example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: test.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccexampleConfig(exampleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "example_name", exampleName),
+					// check all the other attributes
+				),
+			},
+		},
+	})
+}
+
+func testAccexampleConfig(exampleName string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_example_example" "testing_example" {
+		
+	}`, exampleName)
+}
@@ -4,21 +4,27 @@ package generated
 
 import (
 	"context"
-	"example.com/apisdk"
 	"fmt"
+	"strings"
+
+	"example.com/apisdk"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
-	"strings"
-
-	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 )
 
 func ExampleProviderSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"list_list_attribute_assoc_ext_type": schema.ListAttribute{
+				ElementType: types.ListType{
+					ElemType: types.Int64Type,
+				},
+				Optional: true,
+			},
 			"list_nested_attribute_assoc_ext_type": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -38,11 +44,12 @@ func ExampleProviderSchema(ctx context.Context) schema.Schema {
 							Optional: true,
 						},
 					},
-					CustomType: ListNestedAttributeAssocExtTypeType{
-						ObjectType: types.ObjectType{
-							AttrTypes: ListNestedAttributeAssocExtTypeValue{}.AttributeTypes(ctx),
-						},
-					},
+				},
+				Optional: true,
+			},
+			"map_list_attribute_assoc_ext_type": schema.MapAttribute{
+				ElementType: types.ListType{
+					ElemType: types.StringType,
 				},
 				Optional: true,
 			},
@@ -65,11 +72,19 @@ func ExampleProviderSchema(ctx context.Context) schema.Schema {
 							Optional: true,
 						},
 					},
-					CustomType: MapNestedAttributeAssocExtTypeType{
-						ObjectType: types.ObjectType{
-							AttrTypes: MapNestedAttributeAssocExtTypeValue{}.AttributeTypes(ctx),
+				},
+				Optional: true,
+			},
+			"object_object_attribute_assoc_ext_type": schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{
+					"nested_object": types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"bool_attribute": types.BoolType,
 						},
 					},
+					"string_map": types.MapType{
+						ElemType: types.StringType,
+					},
 				},
 				Optional: true,
 			},
@@ -92,10 +107,16 @@ func ExampleProviderSchema(ctx context.Context) schema.Schema {
 							Optional: true,
 						},
 					},
-					CustomType: SetNestedAttributeAssocExtTypeType{
-						ObjectType: types.ObjectType{
-							AttrTypes: SetNestedAttributeAssocExtTypeValue{}.AttributeTypes(ctx),
+				},
+				Optional: true,
+			},
+			"set_object_attribute_assoc_ext_type": schema.SetAttribute{
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"int64_list": types.ListType{
+							ElemType: types.Int64Type,
 						},
+						"string_attribute": types.StringType,
 					},
 				},
 				Optional: true,
@@ -118,11 +139,6 @@ func ExampleProviderSchema(ctx context.Context) schema.Schema {
 						Optional: true,
 					},
 				},
-				CustomType: SingleNestedAttributeAssocExtTypeType{
-					ObjectType: types.ObjectType{
-						AttrTypes: SingleNestedAttributeAssocExtTypeValue{}.AttributeTypes(ctx),
-					},
-				},
 				Optional: true,
 			},
 		},
@@ -146,11 +162,6 @@ func ExampleProviderSchema(ctx context.Context) schema.Schema {
 							Optional: true,
 						},
 					},
-					CustomType: ListNestedBlockAssocExtTypeType{
-						ObjectType: types.ObjectType{
-							AttrTypes: ListNestedBlockAssocExtTypeValue{}.AttributeTypes(ctx),
-						},
-					},
 				},
 			},
 			"set_nested_block_assoc_ext_type": schema.SetNestedBlock{
@@ -172,11 +183,6 @@ func ExampleProviderSchema(ctx context.Context) schema.Schema {
 							Optional: true,
 						},
 					},
-					CustomType: SetNestedBlockAssocExtTypeType{
-						ObjectType: types.ObjectType{
-							AttrTypes: SetNestedBlockAssocExtTypeValue{}.AttributeTypes(ctx),
-						},
-					},
 				},
 			},
 			"single_nested_block_assoc_ext_type": schema.SingleNestedBlock{
@@ -197,11 +203,6 @@ func ExampleProviderSchema(ctx context.Context) schema.Schema {
 						Optional: true,
 					},
 				},
-				CustomType: SingleNestedBlockAssocExtTypeType{
-					ObjectType: types.ObjectType{
-						AttrTypes: SingleNestedBlockAssocExtTypeValue{}.AttributeTypes(ctx),
-					},
-				},
 			},
 		},
 		Description:         "\"Example\" provider",
@@ -211,15 +212,97 @@ func ExampleProviderSchema(ctx context.Context) schema.Schema {
 }
 
 type ExampleModel struct {
+	ListListAttributeAssocExtType     types.List                             `tfsdk:"list_list_attribute_assoc_ext_type"`
 	ListNestedAttributeAssocExtType   types.List                             `tfsdk:"list_nested_attribute_assoc_ext_type"`
+	MapListAttributeAssocExtType      types.Map                              `tfsdk:"map_list_attribute_assoc_ext_type"`
 	MapNestedAttributeAssocExtType    types.Map                              `tfsdk:"map_nested_attribute_assoc_ext_type"`
+	ObjectObjectAttributeAssocExtType types.Object                           `tfsdk:"object_object_attribute_assoc_ext_type"`
 	SetNestedAttributeAssocExtType    types.Set                              `tfsdk:"set_nested_attribute_assoc_ext_type"`
+	SetObjectAttributeAssocExtType    types.Set                              `tfsdk:"set_object_attribute_assoc_ext_type"`
 	SingleNestedAttributeAssocExtType SingleNestedAttributeAssocExtTypeValue `tfsdk:"single_nested_attribute_assoc_ext_type"`
 	ListNestedBlockAssocExtType       types.List                             `tfsdk:"list_nested_block_assoc_ext_type"`
 	SetNestedBlockAssocExtType        types.Set                              `tfsdk:"set_nested_block_assoc_ext_type"`
 	SingleNestedBlockAssocExtType     SingleNestedBlockAssocExtTypeValue     `tfsdk:"single_nested_block_assoc_ext_type"`
 }
 
+var _ basetypes.ListTypable = ListListAttributeAssocExtTypeType{}
+
+type ListListAttributeAssocExtTypeType struct {
+	basetypes.ListType
+}
+
+func (t ListListAttributeAssocExtTypeType) Equal(o attr.Type) bool {
+	other, ok := o.(ListListAttributeAssocExtTypeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ListType.Equal(other.ListType)
+}
+
+func (t ListListAttributeAssocExtTypeType) String() string {
+	return "ListListAttributeAssocExtTypeType"
+}
+
+func (t ListListAttributeAssocExtTypeType) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return ListListAttributeAssocExtTypeValue{
+		ListValue: in,
+	}, nil
+}
+
+func (t ListListAttributeAssocExtTypeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := attrValue.(basetypes.ListValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	listValuable, diags := t.ValueFromList(ctx, listValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ListValue to ListValuable: %v", diags)
+	}
+
+	return listValuable, nil
+}
+
+func (t ListListAttributeAssocExtTypeType) ValueType(ctx context.Context) attr.Value {
+	return ListListAttributeAssocExtTypeValue{}
+}
+
+var _ basetypes.ListValuable = ListListAttributeAssocExtTypeValue{}
+
+type ListListAttributeAssocExtTypeValue struct {
+	basetypes.ListValue
+}
+
+func (v ListListAttributeAssocExtTypeValue) Equal(o attr.Value) bool {
+	other, ok := o.(ListListAttributeAssocExtTypeValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.ListValue.Equal(other.ListValue)
+}
+
+func (v ListListAttributeAssocExtTypeValue) Type(ctx context.Context) attr.Type {
+	return ListListAttributeAssocExtTypeType{
+		ListType: basetypes.ListType{
+			ElemType: types.ListType{
+				ElemType: types.Int64Type,
+			},
+		},
+	}
+}
+
 var _ basetypes.ObjectTypable = ListNestedAttributeAssocExtTypeType{}
 
 type ListNestedAttributeAssocExtTypeType struct {
@@ -764,6 +847,84 @@ func (v ListNestedAttributeAssocExtTypeValue) AttributeTypes(ctx context.Context
 	}
 }
 
+var _ basetypes.MapTypable = MapListAttributeAssocExtTypeType{}
+
+type MapListAttributeAssocExtTypeType struct {
+	basetypes.MapType
+}
+
+func (t MapListAttributeAssocExtTypeType) Equal(o attr.Type) bool {
+	other, ok := o.(MapListAttributeAssocExtTypeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.MapType.Equal(other.MapType)
+}
+
+func (t MapListAttributeAssocExtTypeType) String() string {
+	return "MapListAttributeAssocExtTypeType"
+}
+
+func (t MapListAttributeAssocExtTypeType) ValueFromMap(ctx context.Context, in basetypes.MapValue) (basetypes.MapValuable, diag.Diagnostics) {
+	return MapListAttributeAssocExtTypeValue{
+		MapValue: in,
+	}, nil
+}
+
+func (t MapListAttributeAssocExtTypeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.MapType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mapValue, ok := attrValue.(basetypes.MapValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	mapValuable, diags := t.ValueFromMap(ctx, mapValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting MapValue to MapValuable: %v", diags)
+	}
+
+	return mapValuable, nil
+}
+
+func (t MapListAttributeAssocExtTypeType) ValueType(ctx context.Context) attr.Value {
+	return MapListAttributeAssocExtTypeValue{}
+}
+
+var _ basetypes.MapValuable = MapListAttributeAssocExtTypeValue{}
+
+type MapListAttributeAssocExtTypeValue struct {
+	basetypes.MapValue
+}
+
+func (v MapListAttributeAssocExtTypeValue) Equal(o attr.Value) bool {
+	other, ok := o.(MapListAttributeAssocExtTypeValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.MapValue.Equal(other.MapValue)
+}
+
+func (v MapListAttributeAssocExtTypeValue) Type(ctx context.Context) attr.Type {
+	return MapListAttributeAssocExtTypeType{
+		MapType: basetypes.MapType{
+			ElemType: types.ListType{
+				ElemType: types.StringType,
+			},
+		},
+	}
+}
+
 var _ basetypes.ObjectTypable = MapNestedAttributeAssocExtTypeType{}
 
 type MapNestedAttributeAssocExtTypeType struct {
@@ -1308,6 +1469,95 @@ func (v MapNestedAttributeAssocExtTypeValue) AttributeTypes(ctx context.Context)
 	}
 }
 
+var _ basetypes.ObjectTypable = ObjectObjectAttributeAssocExtTypeType{}
+
+type ObjectObjectAttributeAssocExtTypeType struct {
+	basetypes.ObjectType
+}
+
+func (t ObjectObjectAttributeAssocExtTypeType) Equal(o attr.Type) bool {
+	other, ok := o.(ObjectObjectAttributeAssocExtTypeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t ObjectObjectAttributeAssocExtTypeType) String() string {
+	return "ObjectObjectAttributeAssocExtTypeType"
+}
+
+func (t ObjectObjectAttributeAssocExtTypeType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	return ObjectObjectAttributeAssocExtTypeValue{
+		ObjectValue: in,
+	}, nil
+}
+
+func (t ObjectObjectAttributeAssocExtTypeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ObjectType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	objectValue, ok := attrValue.(basetypes.ObjectValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	objectValuable, diags := t.ValueFromObject(ctx, objectValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ObjectValue to ObjectValuable: %v", diags)
+	}
+
+	return objectValuable, nil
+}
+
+func (t ObjectObjectAttributeAssocExtTypeType) ValueType(ctx context.Context) attr.Value {
+	return ObjectObjectAttributeAssocExtTypeValue{}
+}
+
+var _ basetypes.ObjectValuable = ObjectObjectAttributeAssocExtTypeValue{}
+
+type ObjectObjectAttributeAssocExtTypeValue struct {
+	basetypes.ObjectValue
+}
+
+func (v ObjectObjectAttributeAssocExtTypeValue) Equal(o attr.Value) bool {
+	other, ok := o.(ObjectObjectAttributeAssocExtTypeValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.ObjectValue.Equal(other.ObjectValue)
+}
+
+func (v ObjectObjectAttributeAssocExtTypeValue) Type(ctx context.Context) attr.Type {
+	return ObjectObjectAttributeAssocExtTypeType{
+		ObjectType: basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v ObjectObjectAttributeAssocExtTypeValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"nested_object": types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"bool_attribute": types.BoolType,
+			},
+		},
+		"string_map": types.MapType{
+			ElemType: types.StringType,
+		},
+	}
+}
+
 var _ basetypes.ObjectTypable = SetNestedAttributeAssocExtTypeType{}
 
 type SetNestedAttributeAssocExtTypeType struct {
@@ -1852,6 +2102,89 @@ func (v SetNestedAttributeAssocExtTypeValue) AttributeTypes(ctx context.Context)
 	}
 }
 
+var _ basetypes.SetTypable = SetObjectAttributeAssocExtTypeType{}
+
+type SetObjectAttributeAssocExtTypeType struct {
+	basetypes.SetType
+}
+
+func (t SetObjectAttributeAssocExtTypeType) Equal(o attr.Type) bool {
+	other, ok := o.(SetObjectAttributeAssocExtTypeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.SetType.Equal(other.SetType)
+}
+
+func (t SetObjectAttributeAssocExtTypeType) String() string {
+	return "SetObjectAttributeAssocExtTypeType"
+}
+
+func (t SetObjectAttributeAssocExtTypeType) ValueFromSet(ctx context.Context, in basetypes.SetValue) (basetypes.SetValuable, diag.Diagnostics) {
+	return SetObjectAttributeAssocExtTypeValue{
+		SetValue: in,
+	}, nil
+}
+
+func (t SetObjectAttributeAssocExtTypeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.SetType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := attrValue.(basetypes.SetValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	listValuable, diags := t.ValueFromSet(ctx, listValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting SetValue to SetValuable: %v", diags)
+	}
+
+	return listValuable, nil
+}
+
+func (t SetObjectAttributeAssocExtTypeType) ValueType(ctx context.Context) attr.Value {
+	return SetObjectAttributeAssocExtTypeValue{}
+}
+
+var _ basetypes.SetValuable = SetObjectAttributeAssocExtTypeValue{}
+
+type SetObjectAttributeAssocExtTypeValue struct {
+	basetypes.SetValue
+}
+
+func (v SetObjectAttributeAssocExtTypeValue) Equal(o attr.Value) bool {
+	other, ok := o.(SetObjectAttributeAssocExtTypeValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.SetValue.Equal(other.SetValue)
+}
+
+func (v SetObjectAttributeAssocExtTypeValue) Type(ctx context.Context) attr.Type {
+	return SetObjectAttributeAssocExtTypeType{
+		SetType: basetypes.SetType{
+			ElemType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"int64_list": types.ListType{
+						ElemType: types.Int64Type,
+					},
+					"string_attribute": types.StringType,
+				},
+			},
+		},
+	}
+}
+
 var _ basetypes.ObjectTypable = SingleNestedAttributeAssocExtTypeType{}
 
 type SingleNestedAttributeAssocExtTypeType struct {
@@ -4028,6 +4361,125 @@ func (v SingleNestedBlockAssocExtTypeValue) AttributeTypes(ctx context.Context)
 	}
 }
 
+func (v ListListAttributeAssocExtTypeValue) ToApisdkListList(ctx context.Context) (*apisdk.ListList, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"ListListAttributeAssocExtTypeValue Value Is Unknown",
+			`"ListListAttributeAssocExtTypeValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	var apisdkListList apisdk.ListList
+
+	apisdkListList = make(apisdk.ListList, 0, len(v.Elements()))
+
+	for _, elem1 := range v.Elements() {
+		val2, ok := elem1.(types.List)
+
+		if !ok {
+			diags.Append(diag.NewErrorDiagnostic(
+				"Value Is Wrong Type",
+				fmt.Sprintf(`expected types.List, was: %T`, elem1),
+			))
+
+			return nil, diags
+		}
+
+		var goVal3 []*int64
+
+		if !val2.IsNull() && !val2.IsUnknown() {
+			goVal3 = make([]*int64, 0, len(val2.Elements()))
+
+			for _, elem4 := range val2.Elements() {
+				val5, ok := elem4.(types.Int64)
+
+				if !ok {
+					diags.Append(diag.NewErrorDiagnostic(
+						"Value Is Wrong Type",
+						fmt.Sprintf(`expected types.Int64, was: %T`, elem4),
+					))
+
+					return nil, diags
+				}
+
+				goVal3 = append(goVal3, val5.ValueInt64Pointer())
+			}
+		}
+
+		apisdkListList = append(apisdkListList, goVal3)
+	}
+
+	return &apisdkListList, diags
+}
+
+func (v ListListAttributeAssocExtTypeValue) FromApisdkListList(ctx context.Context, apiObject *apisdk.ListList) (ListListAttributeAssocExtTypeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return ListListAttributeAssocExtTypeValue{
+			types.ListNull(types.ListType{
+				ElemType: types.Int64Type,
+			}),
+		}, diags
+	}
+
+	elems1 := make([]attr.Value, 0, len(*apiObject))
+
+	for _, elem2 := range *apiObject {
+		val3 := types.ListNull(types.Int64Type)
+
+		if elem2 != nil {
+			elems5 := make([]attr.Value, 0, len(elem2))
+
+			for _, elem6 := range elem2 {
+				elems5 = append(elems5, types.Int64PointerValue(elem6))
+			}
+
+			val4, d := types.ListValue(types.Int64Type, elems5)
+
+			diags.Append(d...)
+
+			if diags.HasError() {
+				return ListListAttributeAssocExtTypeValue{
+					types.ListUnknown(types.ListType{
+						ElemType: types.Int64Type,
+					}),
+				}, diags
+			}
+
+			val3 = val4
+		}
+
+		elems1 = append(elems1, val3)
+	}
+
+	l, d := types.ListValue(types.ListType{
+		ElemType: types.Int64Type,
+	}, elems1)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return ListListAttributeAssocExtTypeValue{
+			types.ListUnknown(types.ListType{
+				ElemType: types.Int64Type,
+			}),
+		}, diags
+	}
+
+	return ListListAttributeAssocExtTypeValue{
+		l,
+	}, diags
+}
+
 func (v ListNestedAttributeAssocExtTypeValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -4070,6 +4522,125 @@ func (v ListNestedAttributeAssocExtTypeValue) FromApisdkType(ctx context.Context
 	}, diags
 }
 
+func (v MapListAttributeAssocExtTypeValue) ToApisdkMapList(ctx context.Context) (*apisdk.MapList, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"MapListAttributeAssocExtTypeValue Value Is Unknown",
+			`"MapListAttributeAssocExtTypeValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	var apisdkMapList apisdk.MapList
+
+	apisdkMapList = make(apisdk.MapList, len(v.Elements()))
+
+	for key1, elem2 := range v.Elements() {
+		val3, ok := elem2.(types.List)
+
+		if !ok {
+			diags.Append(diag.NewErrorDiagnostic(
+				"Value Is Wrong Type",
+				fmt.Sprintf(`expected types.List, was: %T`, elem2),
+			))
+
+			return nil, diags
+		}
+
+		var goVal4 []*string
+
+		if !val3.IsNull() && !val3.IsUnknown() {
+			goVal4 = make([]*string, 0, len(val3.Elements()))
+
+			for _, elem5 := range val3.Elements() {
+				val6, ok := elem5.(types.String)
+
+				if !ok {
+					diags.Append(diag.NewErrorDiagnostic(
+						"Value Is Wrong Type",
+						fmt.Sprintf(`expected types.String, was: %T`, elem5),
+					))
+
+					return nil, diags
+				}
+
+				goVal4 = append(goVal4, val6.ValueStringPointer())
+			}
+		}
+
+		apisdkMapList[key1] = goVal4
+	}
+
+	return &apisdkMapList, diags
+}
+
+func (v MapListAttributeAssocExtTypeValue) FromApisdkMapList(ctx context.Context, apiObject *apisdk.MapList) (MapListAttributeAssocExtTypeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return MapListAttributeAssocExtTypeValue{
+			types.MapNull(types.ListType{
+				ElemType: types.StringType,
+			}),
+		}, diags
+	}
+
+	elems1 := make(map[string]attr.Value, len(*apiObject))
+
+	for key2, elem3 := range *apiObject {
+		val4 := types.ListNull(types.StringType)
+
+		if elem3 != nil {
+			elems6 := make([]attr.Value, 0, len(elem3))
+
+			for _, elem7 := range elem3 {
+				elems6 = append(elems6, types.StringPointerValue(elem7))
+			}
+
+			val5, d := types.ListValue(types.StringType, elems6)
+
+			diags.Append(d...)
+
+			if diags.HasError() {
+				return MapListAttributeAssocExtTypeValue{
+					types.MapUnknown(types.ListType{
+						ElemType: types.StringType,
+					}),
+				}, diags
+			}
+
+			val4 = val5
+		}
+
+		elems1[key2] = val4
+	}
+
+	l, d := types.MapValue(types.ListType{
+		ElemType: types.StringType,
+	}, elems1)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return MapListAttributeAssocExtTypeValue{
+			types.MapUnknown(types.ListType{
+				ElemType: types.StringType,
+			}),
+		}, diags
+	}
+
+	return MapListAttributeAssocExtTypeValue{
+		l,
+	}, diags
+}
+
 func (v MapNestedAttributeAssocExtTypeValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -4112,6 +4683,159 @@ func (v MapNestedAttributeAssocExtTypeValue) FromApisdkType(ctx context.Context,
 	}, diags
 }
 
+func (v ObjectObjectAttributeAssocExtTypeValue) ToApisdkObjectObject(ctx context.Context) (*apisdk.ObjectObject, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"ObjectObjectAttributeAssocExtTypeValue Value Is Unknown",
+			`"ObjectObjectAttributeAssocExtTypeValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	var apisdkObjectObject apisdk.ObjectObject
+
+	attributes1 := v.Attributes()
+
+	val2, ok := attributes1["nested_object"].(types.Object)
+
+	if !ok {
+		diags.Append(diag.NewErrorDiagnostic(
+			"Value Is Wrong Type",
+			fmt.Sprintf(`expected types.Object, was: %T`, attributes1["nested_object"]),
+		))
+
+		return nil, diags
+	}
+
+	if !val2.IsNull() && !val2.IsUnknown() {
+		attributes3 := val2.Attributes()
+
+		val4, ok := attributes3["bool_attribute"].(types.Bool)
+
+		if !ok {
+			diags.Append(diag.NewErrorDiagnostic(
+				"Value Is Wrong Type",
+				fmt.Sprintf(`expected types.Bool, was: %T`, attributes3["bool_attribute"]),
+			))
+
+			return nil, diags
+		}
+
+		apisdkObjectObject.NestedObject.BoolAttribute = val4.ValueBoolPointer()
+	}
+
+	val5, ok := attributes1["string_map"].(types.Map)
+
+	if !ok {
+		diags.Append(diag.NewErrorDiagnostic(
+			"Value Is Wrong Type",
+			fmt.Sprintf(`expected types.Map, was: %T`, attributes1["string_map"]),
+		))
+
+		return nil, diags
+	}
+
+	if !val5.IsNull() && !val5.IsUnknown() {
+		apisdkObjectObject.StringMap = make(map[string]*string, len(val5.Elements()))
+
+		for key6, elem7 := range val5.Elements() {
+			val8, ok := elem7.(types.String)
+
+			if !ok {
+				diags.Append(diag.NewErrorDiagnostic(
+					"Value Is Wrong Type",
+					fmt.Sprintf(`expected types.String, was: %T`, elem7),
+				))
+
+				return nil, diags
+			}
+
+			apisdkObjectObject.StringMap[key6] = val8.ValueStringPointer()
+		}
+	}
+
+	return &apisdkObjectObject, diags
+}
+
+func (v ObjectObjectAttributeAssocExtTypeValue) FromApisdkObjectObject(ctx context.Context, apiObject *apisdk.ObjectObject) (ObjectObjectAttributeAssocExtTypeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return ObjectObjectAttributeAssocExtTypeValue{
+			types.ObjectNull(v.AttributeTypes(ctx)),
+		}, diags
+	}
+
+	val1, d := types.ObjectValue(map[string]attr.Type{
+		"bool_attribute": types.BoolType,
+	}, map[string]attr.Value{
+		"bool_attribute": types.BoolPointerValue(apiObject.NestedObject.BoolAttribute),
+	})
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return ObjectObjectAttributeAssocExtTypeValue{
+			types.ObjectUnknown(v.AttributeTypes(ctx)),
+		}, diags
+	}
+
+	val2 := types.MapNull(types.StringType)
+
+	if apiObject.StringMap != nil {
+		elems4 := make(map[string]attr.Value, len(apiObject.StringMap))
+
+		for key5, elem6 := range apiObject.StringMap {
+			elems4[key5] = types.StringPointerValue(elem6)
+		}
+
+		val3, d := types.MapValue(types.StringType, elems4)
+
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return ObjectObjectAttributeAssocExtTypeValue{
+				types.ObjectUnknown(v.AttributeTypes(ctx)),
+			}, diags
+		}
+
+		val2 = val3
+	}
+
+	o, d := types.ObjectValue(map[string]attr.Type{
+		"nested_object": types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"bool_attribute": types.BoolType,
+			},
+		},
+		"string_map": types.MapType{
+			ElemType: types.StringType,
+		},
+	}, map[string]attr.Value{
+		"nested_object": val1,
+		"string_map":    val2,
+	})
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return ObjectObjectAttributeAssocExtTypeValue{
+			types.ObjectUnknown(v.AttributeTypes(ctx)),
+		}, diags
+	}
+
+	return ObjectObjectAttributeAssocExtTypeValue{
+		o,
+	}, diags
+}
+
 func (v SetNestedAttributeAssocExtTypeValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -4154,6 +4878,201 @@ func (v SetNestedAttributeAssocExtTypeValue) FromApisdkType(ctx context.Context,
 	}, diags
 }
 
+func (v SetObjectAttributeAssocExtTypeValue) ToApisdkSetObject(ctx context.Context) (*apisdk.SetObject, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"SetObjectAttributeAssocExtTypeValue Value Is Unknown",
+			`"SetObjectAttributeAssocExtTypeValue" is unknown.`,
+		))
+
+		return nil, diags
+	}
+
+	var apisdkSetObject apisdk.SetObject
+
+	apisdkSetObject = make(apisdk.SetObject, 0, len(v.Elements()))
+
+	for _, elem1 := range v.Elements() {
+		val2, ok := elem1.(types.Object)
+
+		if !ok {
+			diags.Append(diag.NewErrorDiagnostic(
+				"Value Is Wrong Type",
+				fmt.Sprintf(`expected types.Object, was: %T`, elem1),
+			))
+
+			return nil, diags
+		}
+
+		var goVal3 struct {
+			Int64List       []*int64
+			StringAttribute *string
+		}
+
+		if !val2.IsNull() && !val2.IsUnknown() {
+			attributes4 := val2.Attributes()
+
+			val5, ok := attributes4["int64_list"].(types.List)
+
+			if !ok {
+				diags.Append(diag.NewErrorDiagnostic(
+					"Value Is Wrong Type",
+					fmt.Sprintf(`expected types.List, was: %T`, attributes4["int64_list"]),
+				))
+
+				return nil, diags
+			}
+
+			if !val5.IsNull() && !val5.IsUnknown() {
+				goVal3.Int64List = make([]*int64, 0, len(val5.Elements()))
+
+				for _, elem6 := range val5.Elements() {
+					val7, ok := elem6.(types.Int64)
+
+					if !ok {
+						diags.Append(diag.NewErrorDiagnostic(
+							"Value Is Wrong Type",
+							fmt.Sprintf(`expected types.Int64, was: %T`, elem6),
+						))
+
+						return nil, diags
+					}
+
+					goVal3.Int64List = append(goVal3.Int64List, val7.ValueInt64Pointer())
+				}
+			}
+
+			val8, ok := attributes4["string_attribute"].(types.String)
+
+			if !ok {
+				diags.Append(diag.NewErrorDiagnostic(
+					"Value Is Wrong Type",
+					fmt.Sprintf(`expected types.String, was: %T`, attributes4["string_attribute"]),
+				))
+
+				return nil, diags
+			}
+
+			goVal3.StringAttribute = val8.ValueStringPointer()
+		}
+
+		apisdkSetObject = append(apisdkSetObject, goVal3)
+	}
+
+	return &apisdkSetObject, diags
+}
+
+func (v SetObjectAttributeAssocExtTypeValue) FromApisdkSetObject(ctx context.Context, apiObject *apisdk.SetObject) (SetObjectAttributeAssocExtTypeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return SetObjectAttributeAssocExtTypeValue{
+			types.SetNull(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"int64_list": types.ListType{
+						ElemType: types.Int64Type,
+					},
+					"string_attribute": types.StringType,
+				},
+			}),
+		}, diags
+	}
+
+	elems1 := make([]attr.Value, 0, len(*apiObject))
+
+	for _, elem2 := range *apiObject {
+		val4 := types.ListNull(types.Int64Type)
+
+		if elem2.Int64List != nil {
+			elems6 := make([]attr.Value, 0, len(elem2.Int64List))
+
+			for _, elem7 := range elem2.Int64List {
+				elems6 = append(elems6, types.Int64PointerValue(elem7))
+			}
+
+			val5, d := types.ListValue(types.Int64Type, elems6)
+
+			diags.Append(d...)
+
+			if diags.HasError() {
+				return SetObjectAttributeAssocExtTypeValue{
+					types.SetUnknown(types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"int64_list": types.ListType{
+								ElemType: types.Int64Type,
+							},
+							"string_attribute": types.StringType,
+						},
+					}),
+				}, diags
+			}
+
+			val4 = val5
+		}
+
+		val3, d := types.ObjectValue(map[string]attr.Type{
+			"int64_list": types.ListType{
+				ElemType: types.Int64Type,
+			},
+			"string_attribute": types.StringType,
+		}, map[string]attr.Value{
+			"int64_list":       val4,
+			"string_attribute": types.StringPointerValue(elem2.StringAttribute),
+		})
+
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return SetObjectAttributeAssocExtTypeValue{
+				types.SetUnknown(types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"int64_list": types.ListType{
+							ElemType: types.Int64Type,
+						},
+						"string_attribute": types.StringType,
+					},
+				}),
+			}, diags
+		}
+
+		elems1 = append(elems1, val3)
+	}
+
+	l, d := types.SetValue(types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"int64_list": types.ListType{
+				ElemType: types.Int64Type,
+			},
+			"string_attribute": types.StringType,
+		},
+	}, elems1)
+
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return SetObjectAttributeAssocExtTypeValue{
+			types.SetUnknown(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"int64_list": types.ListType{
+						ElemType: types.Int64Type,
+					},
+					"string_attribute": types.StringType,
+				},
+			}),
+		}, diags
+	}
+
+	return SetObjectAttributeAssocExtTypeValue{
+		l,
+	}, diags
+}
+
 func (v SingleNestedAttributeAssocExtTypeValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -0,0 +1,125 @@
+package generated_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"example.com/generated/ncloudsdk"
+	"github.com/NaverCloudPlatform/terraform-codegen-poc/internal/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNcloudExample_example_basic(t *testing.T) {
+	suffix := acctest.RandString(5)
+
+	resourceName := "ncloud_example_example.testing_example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: test.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckExampleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccexampleConfig(suffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckexampleExists(resourceName, test.GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "list_nested_attribute_assoc_ext_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "list_nested_attribute_assoc_ext_type.0.bool_attribute", "true"),
+					resource.TestCheckResourceAttr(resourceName, "list_nested_attribute_assoc_ext_type.0.float64_attribute", "1.5"),
+					resource.TestCheckResourceAttr(resourceName, "list_nested_attribute_assoc_ext_type.0.int64_attribute", "1"),
+					resource.TestCheckResourceAttr(resourceName, "list_nested_attribute_assoc_ext_type.0.number_attribute", "1.5"),
+					resource.TestCheckResourceAttr(resourceName, "list_nested_attribute_assoc_ext_type.0.string_attribute", "tf-"+suffix),
+					resource.TestCheckResourceAttr(resourceName, "map_nested_attribute_assoc_ext_type.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "map_nested_attribute_assoc_ext_type.key.bool_attribute", "true"),
+					resource.TestCheckResourceAttr(resourceName, "map_nested_attribute_assoc_ext_type.key.float64_attribute", "1.5"),
+					resource.TestCheckResourceAttr(resourceName, "map_nested_attribute_assoc_ext_type.key.int64_attribute", "1"),
+					resource.TestCheckResourceAttr(resourceName, "map_nested_attribute_assoc_ext_type.key.number_attribute", "1.5"),
+					resource.TestCheckResourceAttr(resourceName, "map_nested_attribute_assoc_ext_type.key.string_attribute", "tf-"+suffix),
+					resource.TestCheckResourceAttr(resourceName, "set_nested_attribute_assoc_ext_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_nested_attribute_assoc_ext_type.bool_attribute", "true"),
+					resource.TestCheckResourceAttr(resourceName, "single_nested_attribute_assoc_ext_type.float64_attribute", "1.5"),
+					resource.TestCheckResourceAttr(resourceName, "single_nested_attribute_assoc_ext_type.int64_attribute", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_nested_attribute_assoc_ext_type.number_attribute", "1.5"),
+					resource.TestCheckResourceAttr(resourceName, "single_nested_attribute_assoc_ext_type.string_attribute", "tf-"+suffix),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckexampleExists(n string, provider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		c := testAccexampleClient()
+
+		_, err := c.GETExamplesExampleid_TF(context.Background(), &ncloudsdk.PrimitiveGETExamplesExampleidRequest{
+			Exampleid: rs.Primary.Attributes["id"],
+		})
+
+		return err
+	}
+}
+
+func testAccCheckExampleDestroy(s *terraform.State) error {
+	c := testAccexampleClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_example_example" {
+			continue
+		}
+
+		_, err := c.GETExamplesExampleid_TF(context.Background(), &ncloudsdk.PrimitiveGETExamplesExampleidRequest{
+			Exampleid: rs.Primary.Attributes["id"],
+		})
+		if ncloudsdk.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("example %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccexampleClient returns a client of the site and region the acceptance tests run against.
+func testAccexampleClient() *ncloudsdk.Client {
+	return ncloudsdk.NewFactory(ncloudsdk.Config{
+		AccessKey: os.Getenv("NCLOUD_ACCESS_KEY"),
+		SecretKey: os.Getenv("NCLOUD_SECRET_KEY"),
+		Site:      os.Getenv("NCLOUD_SITE"),
+		Region:    os.Getenv("NCLOUD_REGION"),
+	}).NewClient(ncloudsdk.Endpoints{
+		Default: "https://example.apigw.ntruss.com/api/v1",
+	})
+}
+
+func testAccexampleConfig(suffix string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_example_example" "testing_example" {
+		list_nested_attribute_assoc_ext_type = [{ bool_attribute = true, float64_attribute = 1.5, int64_attribute = 1, number_attribute = 1.5, string_attribute = "tf-%[1]s" }]
+		map_nested_attribute_assoc_ext_type = { key = { bool_attribute = true, float64_attribute = 1.5, int64_attribute = 1, number_attribute = 1.5, string_attribute = "tf-%[1]s" } }
+		set_nested_attribute_assoc_ext_type = [{ bool_attribute = true, float64_attribute = 1.5, int64_attribute = 1, number_attribute = 1.5, string_attribute = "tf-%[1]s" }]
+		single_nested_attribute_assoc_ext_type = { bool_attribute = true, float64_attribute = 1.5, int64_attribute = 1, number_attribute = 1.5, string_attribute = "tf-%[1]s" }
+}`, suffix)
+}
@@ -0,0 +1,299 @@
+package generated
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/generated/ncloudsdk"
+)
+
+// TestUnitProductResource_basic drives the resource through the plugin framework against an in-memory API server,
+// so it runs without credentials.
+func TestUnitProductResource_basic(t *testing.T) {
+	server := newMockProductServer(t)
+	suffix := acctest.RandString(5)
+
+	resourceName := "ncloud_apigw_product.testing_product"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"ncloud": providerserver.NewProtocol6WithError(&mockProductProvider{url: server.URL}),
+		},
+		CheckDestroy: server.checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitproductConfig(suffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "product_name", "tf-"+suffix),
+					resource.TestCheckResourceAttr(resourceName, "description", "tf-"+suffix),
+					resource.TestCheckResourceAttr(resourceName, "subscription_code", "tf-"+suffix),
+					resource.TestCheckResourceAttr(resourceName, "throttle_rate", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testUnitproductConfigUpdate(suffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "product_name", "tf-"+suffix+"-upd"),
+					resource.TestCheckResourceAttr(resourceName, "description", "tf-"+suffix+"-upd"),
+					resource.TestCheckResourceAttr(resourceName, "subscription_code", "tf-"+suffix),
+					resource.TestCheckResourceAttr(resourceName, "throttle_rate", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func testUnitproductConfig(suffix string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_apigw_product" "testing_product" {
+		product_name = "tf-%[1]s"
+		description = "tf-%[1]s"
+		subscription_code = "tf-%[1]s"
+		throttle_rate = 1
+		enabled = true
+}`, suffix)
+}
+
+func testUnitproductConfigUpdate(suffix string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_apigw_product" "testing_product" {
+		product_name = "tf-%[1]s-upd"
+		description = "tf-%[1]s-upd"
+		subscription_code = "tf-%[1]s"
+		throttle_rate = 1
+		enabled = false
+}`, suffix)
+}
+
+// mockProductProvider serves the resource with clients calling the mock API server.
+type mockProductProvider struct {
+	url string
+}
+
+var _ provider.Provider = &mockProductProvider{}
+
+func (p *mockProductProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "ncloud"
+}
+
+func (p *mockProductProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = providerschema.Schema{}
+}
+
+func (p *mockProductProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	factory := &mockProductFactory{url: p.url}
+
+	resp.ResourceData = factory
+	resp.DataSourceData = factory
+}
+
+func (p *mockProductProvider) Resources(_ context.Context) []func() fwresource.Resource {
+	return []func() fwresource.Resource{
+		NewProductResource,
+	}
+}
+
+func (p *mockProductProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// mockProductFactory returns clients calling the mock API server, whatever the endpoint.
+type mockProductFactory struct {
+	url string
+}
+
+var _ ncloudsdk.ClientFactory = &mockProductFactory{}
+
+func (f *mockProductFactory) NewClient(_ ncloudsdk.Endpoints) *ncloudsdk.Client {
+	return ncloudsdk.NewClient(f.url, "access-key", "secret-key")
+}
+
+var mockProductRoutes = []struct {
+	method  string
+	pattern *regexp.Regexp
+	kind    string
+}{
+	{"POST", regexp.MustCompile(`^/products$`), "create"},
+	{"GET", regexp.MustCompile(`^/products/([^/]+)$`), "read"},
+	{"DELETE", regexp.MustCompile(`^/products/([^/]+)$`), "delete"},
+	{"PATCH", regexp.MustCompile(`^/products/([^/]+)$`), "update"},
+}
+
+// mockProductServer is an in-memory fake of the API, serving the paths of crud_parameters.
+// Objects hold the fields of the requests, and are identified by the last path parameter.
+type mockProductServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	objects map[string]map[string]interface{}
+	nextID  int
+}
+
+func newMockProductServer(t *testing.T) *mockProductServer {
+	t.Helper()
+
+	m := &mockProductServer{
+		objects: make(map[string]map[string]interface{}),
+	}
+	m.Server = httptest.NewServer(m)
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+func (m *mockProductServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fields := map[string]interface{}{}
+
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Numbers are kept as sent, so integers beyond the precision of float64 are echoed back unchanged.
+	if len(b) > 0 {
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.UseNumber()
+
+		if err := d.Decode(&fields); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	for k, v := range r.URL.Query() {
+		fields[k] = v[0]
+	}
+
+	for _, route := range mockProductRoutes {
+		if route.method != r.Method {
+			continue
+		}
+
+		match := route.pattern.FindStringSubmatch(r.URL.Path)
+		if match == nil {
+			continue
+		}
+
+		id := match[len(match)-1]
+
+		switch route.kind {
+		case "create":
+			m.nextID++
+			id = strconv.Itoa(m.nextID)
+
+			fields["productId"] = id
+			fields["status"] = "RUN"
+			m.objects[id] = fields
+
+			m.write(w, fields)
+		case "read":
+			obj, ok := m.objects[id]
+			if !ok {
+				m.notFound(w)
+				return
+			}
+
+			m.write(w, obj)
+		case "update":
+			obj, ok := m.objects[id]
+			if !ok {
+				m.notFound(w)
+				return
+			}
+
+			// Fields sent as null clear their value.
+			for k, v := range fields {
+				if v == nil {
+					delete(obj, k)
+					continue
+				}
+				obj[k] = v
+			}
+			obj["status"] = "RUN"
+
+			m.write(w, obj)
+		case "delete":
+			if _, ok := m.objects[id]; !ok {
+				m.notFound(w)
+				return
+			}
+
+			delete(m.objects, id)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte("{}"))
+		}
+
+		return
+	}
+
+	http.NotFound(w, r)
+}
+
+// write responds with the object, nested the way the create response holds the id.
+func (m *mockProductServer) write(w http.ResponseWriter, obj map[string]interface{}) {
+	var body interface{} = obj
+
+	wrapper := []interface{}{"product"}
+	for i := len(wrapper) - 1; i >= 0; i-- {
+		switch step := wrapper[i].(type) {
+		case string:
+			body = map[string]interface{}{step: body}
+		case int:
+			list := make([]interface{}, step+1)
+			list[step] = body
+			body = list
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// notFound responds the way the read operation reports a missing object.
+func (m *mockProductServer) notFound(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, _ = w.Write([]byte(`{"error":{"errorCode":"3000"}}`))
+}
+
+func (m *mockProductServer) checkDestroy(_ *terraform.State) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.objects) > 0 {
+		return fmt.Errorf("product: %d objects still exist", len(m.objects))
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package generated
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/generated/ncloudsdk"
+)
+
+// Diagnostics might not be Required.
+// Because response type of create operation is different from read operation, reload the read response to get unified refresh data.
+func (plan *PostproductresponseModel) refreshFromOutput_createOp(ctx context.Context, c *ncloudsdk.Client, diagnostics *diag.Diagnostics, createRes map[string]interface{}) {
+
+	// Allocate resource id from create response
+	id, err := ncloudsdk.StringAt(createRes, "product", "productId")
+	if err != nil {
+		diagnostics.AddError("CREATING ERROR", fmt.Sprintf("reading id from the create response: %s", err))
+		return
+	}
+
+	// Path parameters holding the resource identifier are read from plan.ID
+	plan.ID = types.StringValue(id)
+
+	// Indicate where to get resource id from create response
+	err = plan.waitResourceCreated(ctx, c, id)
+
+	if err != nil {
+		diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	exists := plan.refreshFromOutput(ctx, c, diagnostics, id)
+	if diagnostics.HasError() {
+		return
+	}
+
+	if !exists {
+		diagnostics.AddError("CREATING ERROR", "GETProductsProductid: resource was not found after creation")
+	}
+}
+
+// refreshFromOutput fills the plan from the read response.
+// It returns false without diagnostics when the resource does not exist anymore.
+func (plan *PostproductresponseModel) refreshFromOutput(ctx context.Context, c *ncloudsdk.Client, diagnostics *diag.Diagnostics, id string) bool {
+
+	response, err := c.GETProductsProductid_TF(ctx, &ncloudsdk.PrimitiveGETProductsProductidRequest{
+		Productid: plan.ID.ValueString(),
+	})
+
+	if ncloudsdk.IsNotFound(err) {
+		return false
+	}
+
+	if err != nil {
+		diagnostics.AddError("READING ERROR", fmt.Sprintf("GETProductsProductid: %s", err))
+		return false
+	}
+
+	var postPlan PostproductresponseModel
+
+	postPlan.ID = types.StringValue(id)
+
+	// Fill required attributes
+
+	postPlan.ProductId, err = ncloudsdk.Convert[types.String](ncloudsdk.AttributeAt(response.Product, "product_id"), types.StringType)
+	if err != nil {
+		diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert product_id: %v", err))
+		return false
+	}
+
+	postPlan.ProductName, err = ncloudsdk.Convert[types.String](ncloudsdk.AttributeAt(response.Product, "product_name"), types.StringType)
+	if err != nil {
+		diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert product_name: %v", err))
+		return false
+	}
+
+	postPlan.Description, err = ncloudsdk.Convert[types.String](ncloudsdk.AttributeAt(response.Product, "description"), types.StringType)
+	if err != nil {
+		diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert description: %v", err))
+		return false
+	}
+
+	postPlan.SubscriptionCode, err = ncloudsdk.Convert[types.String](ncloudsdk.AttributeAt(response.Product, "subscription_code"), types.StringType)
+	if err != nil {
+		diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert subscription_code: %v", err))
+		return false
+	}
+
+	postPlan.ThrottleRate, err = ncloudsdk.Convert[types.Int64](ncloudsdk.AttributeAt(response.Product, "throttle_rate"), types.Int64Type)
+	if err != nil {
+		diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert throttle_rate: %v", err))
+		return false
+	}
+
+	postPlan.Enabled, err = ncloudsdk.Convert[types.Bool](ncloudsdk.AttributeAt(response.Product, "enabled"), types.BoolType)
+	if err != nil {
+		diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert enabled: %v", err))
+		return false
+	}
+
+	postPlan.Timeouts = plan.Timeouts
+
+	*plan = postPlan
+
+	return true
+}
+
+func (plan *PostproductresponseModel) waitResourceCreated(ctx context.Context, c *ncloudsdk.Client, id string) error {
+	timeout, diags := plan.Timeouts.Create(ctx, 1*time.Hour)
+	if diags.HasError() {
+		return fmt.Errorf("error occured while reading the create timeout: %v", diags)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"CREATING", "INIT"},
+		Target:  []string{"RUN"},
+		Refresh: func() (interface{}, string, error) {
+			response, err := c.GETProductsProductid_TF(ctx, &ncloudsdk.PrimitiveGETProductsProductidRequest{
+				Productid: plan.ID.ValueString(),
+			})
+			// The resource may not be readable right after its creation.
+			if ncloudsdk.IsNotFound(err) {
+				return response, "CREATING", nil
+			}
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := ncloudsdk.ValueString(ncloudsdk.AttributeAt(response.Product, "product", "status"))
+
+			switch status {
+			case "ERROR":
+				return response, status, fmt.Errorf("resource reached a failure state: %s", status)
+			}
+
+			return response, status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error occured while waiting for resource to be created: %s", err)
+	}
+	return nil
+}
+
+func (plan *PostproductresponseModel) waitResourceUpdated(ctx context.Context, c *ncloudsdk.Client, id string) error {
+	timeout, diags := plan.Timeouts.Update(ctx, conn.DefaultTimeout)
+	if diags.HasError() {
+		return fmt.Errorf("error occured while reading the update timeout: %v", diags)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"SETTING"},
+		Target:  []string{"RUN"},
+		Refresh: func() (interface{}, string, error) {
+			response, err := c.GETProductsProductid_TF(ctx, &ncloudsdk.PrimitiveGETProductsProductidRequest{
+				Productid: plan.ID.ValueString(),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := ncloudsdk.ValueString(ncloudsdk.AttributeAt(response.Product, "product", "status"))
+
+			return response, status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error occured while waiting for resource to be updated: %s", err)
+	}
+	return nil
+}
+
+func (plan *PostproductresponseModel) waitResourceDeleted(ctx context.Context, c *ncloudsdk.Client, id string) error {
+	timeout, diags := plan.Timeouts.Delete(ctx, 90*time.Second)
+	if diags.HasError() {
+		return fmt.Errorf("error occured while reading the delete timeout: %v", diags)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"DELETING", "TERMTING"},
+		Target:  []string{"DELETED", "TERMT"},
+		Refresh: func() (interface{}, string, error) {
+			response, err := c.GETProductsProductid_TF(ctx, &ncloudsdk.PrimitiveGETProductsProductidRequest{
+				Productid: plan.ID.ValueString(),
+			})
+			if ncloudsdk.IsNotFound(err) {
+				return response, "DELETED", nil
+			}
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := ncloudsdk.ValueString(ncloudsdk.AttributeAt(response.Product, "product", "status"))
+
+			return response, status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error occured while waiting for resource to be deleted: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package generated_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-codegen-poc/internal/test"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/generated/ncloudsdk"
+)
+
+func TestAccResourceNcloudApigw_product_basic(t *testing.T) {
+	suffix := acctest.RandString(5)
+
+	resourceName := "ncloud_apigw_product.testing_product"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: test.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccproductConfig(suffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckproductExists(resourceName, test.GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "product_name", "tf-"+suffix),
+					resource.TestCheckResourceAttr(resourceName, "description", "tf-"+suffix),
+					resource.TestCheckResourceAttr(resourceName, "subscription_code", "tf-"+suffix),
+					resource.TestCheckResourceAttr(resourceName, "throttle_rate", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccproductConfigUpdate(suffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckproductExists(resourceName, test.GetTestProvider(true)),
+					resource.TestCheckResourceAttr(resourceName, "product_name", "tf-"+suffix+"-upd"),
+					resource.TestCheckResourceAttr(resourceName, "description", "tf-"+suffix+"-upd"),
+					resource.TestCheckResourceAttr(resourceName, "subscription_code", "tf-"+suffix),
+					resource.TestCheckResourceAttr(resourceName, "throttle_rate", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func testAccCheckproductExists(n string, provider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		c := testAccproductClient()
+
+		_, err := c.GETProductsProductid_TF(context.Background(), &ncloudsdk.PrimitiveGETProductsProductidRequest{
+			Productid: rs.Primary.Attributes["id"],
+		})
+
+		return err
+	}
+}
+
+func testAccCheckProductDestroy(s *terraform.State) error {
+	c := testAccproductClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_apigw_product" {
+			continue
+		}
+
+		_, err := c.GETProductsProductid_TF(context.Background(), &ncloudsdk.PrimitiveGETProductsProductidRequest{
+			Productid: rs.Primary.Attributes["id"],
+		})
+		if ncloudsdk.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("product %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccproductClient returns a client of the site and region the acceptance tests run against.
+func testAccproductClient() *ncloudsdk.Client {
+	return ncloudsdk.NewFactory(ncloudsdk.Config{
+		AccessKey: os.Getenv("NCLOUD_ACCESS_KEY"),
+		SecretKey: os.Getenv("NCLOUD_SECRET_KEY"),
+		Site:      os.Getenv("NCLOUD_SITE"),
+		Region:    os.Getenv("NCLOUD_REGION"),
+	}).NewClient(ncloudsdk.Endpoints{
+		Default: "https://apigateway.apigw.ntruss.com/api/v1",
+	})
+}
+
+func testAccproductConfig(suffix string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_apigw_product" "testing_product" {
+		product_name = "tf-%[1]s"
+		description = "tf-%[1]s"
+		subscription_code = "tf-%[1]s"
+		throttle_rate = 1
+		enabled = true
+}`, suffix)
+}
+
+func testAccproductConfigUpdate(suffix string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_apigw_product" "testing_product" {
+		product_name = "tf-%[1]s-upd"
+		description = "tf-%[1]s-upd"
+		subscription_code = "tf-%[1]s"
+		throttle_rate = 1
+		enabled = false
+}`, suffix)
+}
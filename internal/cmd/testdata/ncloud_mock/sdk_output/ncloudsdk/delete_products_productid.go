@@ -0,0 +1,27 @@
+package ncloudsdk
+
+import (
+	"context"
+)
+
+type PrimitiveDELETEProductsProductidRequest struct {
+	Productid string `json:"product-id"`
+}
+
+// DELETEProductsProductid_TF calls DELETE /products/{product-id}
+func (c *Client) DELETEProductsProductid_TF(ctx context.Context, r *PrimitiveDELETEProductsProductidRequest) (map[string]interface{}, error) {
+	path := expandPath("/products/{product-id}", map[string]interface{}{
+		"product-id": r.Productid,
+	})
+
+	query := map[string]interface{}{}
+
+	body := map[string]interface{}{}
+
+	data, err := c.do(ctx, "DELETE", path, query, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,44 @@
+package ncloudsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PrimitiveGETProductsProductidRequest struct {
+	Productid string `json:"product-id"`
+}
+
+type PrimitiveGETProductsProductidResponse struct {
+	Product types.Object
+}
+
+// GETProductsProductid_TF calls GET /products/{product-id}
+func (c *Client) GETProductsProductid_TF(ctx context.Context, r *PrimitiveGETProductsProductidRequest) (*PrimitiveGETProductsProductidResponse, error) {
+	path := expandPath("/products/{product-id}", map[string]interface{}{
+		"product-id": r.Productid,
+	})
+
+	query := map[string]interface{}{}
+
+	body := map[string]interface{}{}
+
+	data, err := c.do(ctx, "GET", path, query, body)
+	if err != nil {
+		return nil, notFound(err, []int{404, 400}, []string{"3000"})
+	}
+
+	if len(data) == 0 {
+		return nil, &NotFoundError{Method: "GET", Path: path}
+	}
+
+	response := &PrimitiveGETProductsProductidResponse{}
+
+	response.Product, err = toObject(responseObject(data, "product"))
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
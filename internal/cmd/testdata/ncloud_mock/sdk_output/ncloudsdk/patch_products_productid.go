@@ -0,0 +1,46 @@
+package ncloudsdk
+
+import (
+	"context"
+)
+
+type PrimitivePATCHProductsProductidRequest struct {
+	Productid   string  `json:"product-id"`
+	ProductName string  `json:"productName"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+
+	// NullFields are the names of optional body fields sent as null, to clear their value.
+	NullFields []string `json:"-"`
+}
+
+// PATCHProductsProductid_TF calls PATCH /products/{product-id}
+func (c *Client) PATCHProductsProductid_TF(ctx context.Context, r *PrimitivePATCHProductsProductidRequest) (map[string]interface{}, error) {
+	path := expandPath("/products/{product-id}", map[string]interface{}{
+		"product-id": r.Productid,
+	})
+
+	query := map[string]interface{}{}
+
+	body := map[string]interface{}{}
+	body["productName"] = r.ProductName
+
+	if r.Description != nil {
+		body["description"] = *r.Description
+	}
+
+	if r.Enabled != nil {
+		body["enabled"] = *r.Enabled
+	}
+
+	for _, name := range r.NullFields {
+		body[name] = nil
+	}
+
+	data, err := c.do(ctx, "PATCH", path, query, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,50 @@
+package ncloudsdk
+
+import (
+	"context"
+)
+
+type PrimitivePOSTProductsRequest struct {
+	ProductName      string  `json:"productName"`
+	SubscriptionCode string  `json:"subscriptionCode"`
+	Description      *string `json:"description,omitempty"`
+	ThrottleRate     *int64  `json:"throttleRate,omitempty"`
+	Enabled          *bool   `json:"enabled,omitempty"`
+
+	// NullFields are the names of optional body fields sent as null, to clear their value.
+	NullFields []string `json:"-"`
+}
+
+// POSTProducts_TF calls POST /products
+func (c *Client) POSTProducts_TF(ctx context.Context, r *PrimitivePOSTProductsRequest) (map[string]interface{}, error) {
+	path := expandPath("/products", map[string]interface{}{})
+
+	query := map[string]interface{}{}
+
+	body := map[string]interface{}{}
+	body["productName"] = r.ProductName
+	body["subscriptionCode"] = r.SubscriptionCode
+
+	if r.Description != nil {
+		body["description"] = *r.Description
+	}
+
+	if r.ThrottleRate != nil {
+		body["throttleRate"] = *r.ThrottleRate
+	}
+
+	if r.Enabled != nil {
+		body["enabled"] = *r.Enabled
+	}
+
+	for _, name := range r.NullFields {
+		body[name] = nil
+	}
+
+	data, err := c.do(ctx, "POST", path, query, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"fmt"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+
+	generatorschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/schema"
+)
+
+const defaultFloat32Import = "github.com/hashicorp/terraform-plugin-framework/resource/schema/float32default"
+
+type DefaultFloat32 struct {
+	float32Default *specschema.Float32Default
+}
+
+func NewDefaultFloat32(b *specschema.Float32Default) DefaultFloat32 {
+	return DefaultFloat32{
+		float32Default: b,
+	}
+}
+
+func (d DefaultFloat32) Equal(other DefaultFloat32) bool {
+	return d.float32Default.Equal(other.float32Default)
+}
+
+func (d DefaultFloat32) Imports() *generatorschema.Imports {
+	imports := generatorschema.NewImports()
+
+	if d.float32Default == nil {
+		return imports
+	}
+
+	if d.float32Default.Static != nil {
+		imports.Add(code.Import{
+			Path: defaultFloat32Import,
+		})
+	}
+
+	if d.float32Default.Custom != nil {
+		for _, i := range d.float32Default.Custom.Imports {
+			if len(i.Path) > 0 {
+				imports.Add(i)
+			}
+		}
+	}
+
+	return imports
+}
+
+func (d DefaultFloat32) Schema() []byte {
+	if d.float32Default == nil {
+		return nil
+	}
+
+	if d.float32Default.Static != nil {
+		return []byte(fmt.Sprintf("Default: float32default.StaticFloat32(%g),\n", *d.float32Default.Static))
+	}
+
+	if d.float32Default.Custom != nil && d.float32Default.Custom.SchemaDefinition != "" {
+		return []byte(fmt.Sprintf("Default: %s,\n", d.float32Default.Custom.SchemaDefinition))
+	}
+
+	return nil
+}
@@ -33,6 +33,12 @@ func (e ElementType) ElementType() []byte {
 		} else {
 			b.WriteString("types.BoolType")
 		}
+	case e.elementType.Float32 != nil:
+		if e.elementType.Float32.CustomType != nil {
+			b.WriteString(e.elementType.Float32.CustomType.Type)
+		} else {
+			b.WriteString("types.Float32Type")
+		}
 	case e.elementType.Float64 != nil:
 		if e.elementType.Float64.CustomType != nil {
 			b.WriteString(e.elementType.Float64.CustomType.Type)
@@ -45,6 +51,12 @@ func (e ElementType) ElementType() []byte {
 		} else {
 			b.WriteString("types.Int64Type")
 		}
+	case e.elementType.Int32 != nil:
+		if e.elementType.Int32.CustomType != nil {
+			b.WriteString(e.elementType.Int32.CustomType.Type)
+		} else {
+			b.WriteString("types.Int32Type")
+		}
 	case e.elementType.List != nil:
 		if e.elementType.List.CustomType != nil {
 			b.WriteString(e.elementType.List.CustomType.Type)
@@ -103,6 +115,15 @@ func (e ElementType) Imports() *schema.Imports {
 			Path: schema.TypesImport,
 		})
 		return imports
+	case e.elementType.Float32 != nil:
+		if e.elementType.Float32.CustomType != nil && e.elementType.Float32.CustomType.HasImport() {
+			imports.Add(*e.elementType.Float32.CustomType.Import)
+			return imports
+		}
+		imports.Add(code.Import{
+			Path: schema.TypesImport,
+		})
+		return imports
 	case e.elementType.Float64 != nil:
 		if e.elementType.Float64.CustomType != nil && e.elementType.Float64.CustomType.HasImport() {
 			imports.Add(*e.elementType.Float64.CustomType.Import)
@@ -121,6 +142,15 @@ func (e ElementType) Imports() *schema.Imports {
 			Path: schema.TypesImport,
 		})
 		return imports
+	case e.elementType.Int32 != nil:
+		if e.elementType.Int32.CustomType != nil && e.elementType.Int32.CustomType.HasImport() {
+			imports.Add(*e.elementType.Int32.CustomType.Import)
+			return imports
+		}
+		imports.Add(code.Import{
+			Path: schema.TypesImport,
+		})
+		return imports
 	case e.elementType.List != nil:
 		imports.Add(NewElementType(e.elementType.List.ElementType).Imports().All()...)
 		return imports
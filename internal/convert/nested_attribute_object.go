@@ -51,10 +51,10 @@ func (n NestedAttributeObject) Imports() *schema.Imports {
 	return imports
 }
 
-func (n NestedAttributeObject) Schema() ([]byte, error) {
+func (n NestedAttributeObject) Schema(options schema.SchemaOptions) ([]byte, error) {
 	var b bytes.Buffer
 
-	attributesSchema, err := n.attributes.Schema()
+	attributesSchema, err := n.attributes.Schema(options)
 
 	if err != nil {
 		return nil, err
@@ -64,7 +64,7 @@ func (n NestedAttributeObject) Schema() ([]byte, error) {
 	b.WriteString("Attributes: map[string]schema.Attribute{")
 	b.WriteString(attributesSchema)
 	b.WriteString("\n},\n")
-	b.Write(n.customType.Schema())
+	b.Write(options.CustomTypeSchema(n.customType))
 	b.Write(n.validators.Schema())
 	b.WriteString("},\n")
 
@@ -59,16 +59,16 @@ func (n NestedBlockObject) Imports() *schema.Imports {
 	return imports
 }
 
-func (n NestedBlockObject) Schema() ([]byte, error) {
+func (n NestedBlockObject) Schema(options schema.SchemaOptions) ([]byte, error) {
 	var b bytes.Buffer
 
-	attributesSchema, err := n.attributes.Schema()
+	attributesSchema, err := n.attributes.Schema(options)
 
 	if err != nil {
 		return nil, err
 	}
 
-	blocksSchema, err := n.blocks.Schema()
+	blocksSchema, err := n.blocks.Schema(options)
 
 	if err != nil {
 		return nil, err
@@ -85,7 +85,7 @@ func (n NestedBlockObject) Schema() ([]byte, error) {
 		b.WriteString(blocksSchema)
 		b.WriteString("\n},\n")
 	}
-	b.Write(n.customType.Schema())
+	b.Write(options.CustomTypeSchema(n.customType))
 	b.Write(n.validators.Schema())
 	b.WriteString("},\n")
 
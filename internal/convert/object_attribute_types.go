@@ -42,6 +42,12 @@ func (o ObjectAttributeTypes) AttributeTypes() []byte {
 			} else {
 				b.WriteString(fmt.Sprintf("%q: types.BoolType,", v.Name))
 			}
+		case v.Float32 != nil:
+			if v.Float32.CustomType != nil {
+				b.WriteString(fmt.Sprintf("%q: %s,", v.Name, v.Float32.CustomType.Type))
+			} else {
+				b.WriteString(fmt.Sprintf("%q: types.Float32Type,", v.Name))
+			}
 		case v.Float64 != nil:
 			if v.Float64.CustomType != nil {
 				b.WriteString(fmt.Sprintf("%q: %s,", v.Name, v.Float64.CustomType.Type))
@@ -54,6 +60,12 @@ func (o ObjectAttributeTypes) AttributeTypes() []byte {
 			} else {
 				b.WriteString(fmt.Sprintf("%q: types.Int64Type,", v.Name))
 			}
+		case v.Int32 != nil:
+			if v.Int32.CustomType != nil {
+				b.WriteString(fmt.Sprintf("%q: %s,", v.Name, v.Int32.CustomType.Type))
+			} else {
+				b.WriteString(fmt.Sprintf("%q: types.Int32Type,", v.Name))
+			}
 		case v.List != nil:
 			if v.List.CustomType != nil {
 				b.WriteString(fmt.Sprintf("%q: %s,", v.Name, v.List.CustomType.Type))
@@ -111,6 +123,12 @@ func (o ObjectAttributeTypes) Imports() *schema.Imports {
 				continue
 			}
 			imports.Add(code.Import{Path: schema.AttrImport}, code.Import{Path: schema.TypesImport})
+		case v.Float32 != nil:
+			if v.Float32.CustomType != nil && v.Float32.CustomType.HasImport() {
+				imports.Add(*v.Float32.CustomType.Import)
+				continue
+			}
+			imports.Add(code.Import{Path: schema.AttrImport}, code.Import{Path: schema.TypesImport})
 		case v.Float64 != nil:
 			if v.Float64.CustomType != nil && v.Float64.CustomType.HasImport() {
 				imports.Add(*v.Float64.CustomType.Import)
@@ -123,6 +141,12 @@ func (o ObjectAttributeTypes) Imports() *schema.Imports {
 				continue
 			}
 			imports.Add(code.Import{Path: schema.AttrImport}, code.Import{Path: schema.TypesImport})
+		case v.Int32 != nil:
+			if v.Int32.CustomType != nil && v.Int32.CustomType.HasImport() {
+				imports.Add(*v.Int32.CustomType.Import)
+				continue
+			}
+			imports.Add(code.Import{Path: schema.AttrImport}, code.Import{Path: schema.TypesImport})
 		case v.List != nil:
 			if v.List.CustomType != nil && v.List.CustomType.HasImport() {
 				imports.Add(*v.List.CustomType.Import)
@@ -15,6 +15,7 @@ import (
 
 const (
 	PlanModifierTypeBool    PlanModifierType = "Bool"
+	PlanModifierTypeFloat32 PlanModifierType = "Float32"
 	PlanModifierTypeFloat64 PlanModifierType = "Float64"
 	PlanModifierTypeInt64   PlanModifierType = "Int64"
 	PlanModifierTypeInt32   PlanModifierType = "Int32"
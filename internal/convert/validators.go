@@ -15,6 +15,7 @@ import (
 
 const (
 	ValidatorTypeBool    ValidatorType = "Bool"
+	ValidatorTypeFloat32 ValidatorType = "Float32"
 	ValidatorTypeFloat64 ValidatorType = "Float64"
 	ValidatorTypeInt64   ValidatorType = "Int64"
 	ValidatorTypeInt32   ValidatorType = "Int32"
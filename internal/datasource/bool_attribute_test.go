@@ -562,7 +562,7 @@ my_other_validator.Validate(),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.Schema("bool_attribute")
+			got, err := testCase.input.Schema("bool_attribute", generatorschema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -648,7 +648,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.ModelField("bool_attribute")
+			got, err := testCase.input.ModelField("bool_attribute", generatorschema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
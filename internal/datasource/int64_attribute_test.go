@@ -14,6 +14,7 @@ import (
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/convert"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/model"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/schema"
 )
 
 func TestGeneratorInt64Attribute_New(t *testing.T) {
@@ -312,7 +313,7 @@ my_other_validator.Validate(),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.Schema("int64_attribute")
+			got, err := testCase.input.Schema("int64_attribute", schema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -398,7 +399,7 @@ func TestGeneratorInt64Attribute_ModelField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.ModelField("int64_attribute")
+			got, err := testCase.input.ModelField("int64_attribute", schema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -1598,7 +1598,7 @@ ElementType: stringCustomType,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.Schema("list_attribute")
+			got, err := testCase.input.Schema("list_attribute", generatorschema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -1690,7 +1690,7 @@ func TestGeneratorListAttribute_ModelField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.ModelField("list_attribute")
+			got, err := testCase.input.ModelField("list_attribute", generatorschema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
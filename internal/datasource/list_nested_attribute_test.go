@@ -1665,7 +1665,7 @@ my_other_validator.Validate(),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.Schema("list_nested_attribute")
+			got, err := testCase.input.Schema("list_nested_attribute", generatorschema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -1715,7 +1715,7 @@ func TestGeneratorListNestedAttribute_ModelField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.ModelField("list_nested_attribute")
+			got, err := testCase.input.ModelField("list_nested_attribute", generatorschema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
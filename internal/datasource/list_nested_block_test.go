@@ -1782,7 +1782,7 @@ my_other_validator.Validate(),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.Schema("list_nested_block")
+			got, err := testCase.input.Schema("list_nested_block", generatorschema.SchemaOptions{})
 
 			if err != nil {
 				t.Error(err)
@@ -1832,7 +1832,7 @@ func TestGeneratorListNestedBlock_ModelField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.ModelField("list_nested_block")
+			got, err := testCase.input.ModelField("list_nested_block", generatorschema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
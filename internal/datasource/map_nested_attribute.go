@@ -128,8 +128,8 @@ func (g GeneratorMapNestedAttribute) Equal(ga schema.GeneratorAttribute) bool {
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorMapNestedAttribute) Schema(name schema.FrameworkIdentifier) (string, error) {
-	nestedObjectSchema, err := g.NestedAttributeObject.Schema()
+func (g GeneratorMapNestedAttribute) Schema(name schema.FrameworkIdentifier, options schema.SchemaOptions) (string, error) {
+	nestedObjectSchema, err := g.NestedAttributeObject.Schema(options)
 
 	if err != nil {
 		return "", err
@@ -139,7 +139,7 @@ func (g GeneratorMapNestedAttribute) Schema(name schema.FrameworkIdentifier) (st
 
 	b.WriteString(fmt.Sprintf("%q: schema.MapNestedAttribute{\n", name))
 	b.Write(nestedObjectSchema)
-	b.Write(g.CustomType.Schema())
+	b.Write(options.CustomTypeSchema(g.CustomType))
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
 	b.Write(g.Description.Schema())
@@ -150,14 +150,14 @@ func (g GeneratorMapNestedAttribute) Schema(name schema.FrameworkIdentifier) (st
 	return b.String(), nil
 }
 
-func (g GeneratorMapNestedAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorMapNestedAttribute) ModelField(name schema.FrameworkIdentifier, options schema.SchemaOptions) (model.Field, error) {
 	f := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: model.MapValueType,
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		f.ValueType = customValueType
@@ -236,7 +236,7 @@ func (g GeneratorMapNestedAttribute) CustomTypeAndValue(name string) ([]byte, er
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorMapNestedAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorMapNestedAttribute) ToFromFunctions(name string, typeMapping schema.TypeMapping) ([]byte, error) {
 	if g.NestedObject.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -255,7 +255,7 @@ func (g GeneratorMapNestedAttribute) ToFromFunctions(name string) ([]byte, error
 		return nil, err
 	}
 
-	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs, typeMapping)
 
 	b, err := toFrom.Render()
 
@@ -271,7 +271,7 @@ func (g GeneratorMapNestedAttribute) ToFromFunctions(name string) ([]byte, error
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := c.ToFromFunctions(k, typeMapping)
 
 			if err != nil {
 				return nil, err
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/convert"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/model"
@@ -106,11 +107,11 @@ func (g GeneratorNumberAttribute) Equal(ga schema.GeneratorAttribute) bool {
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorNumberAttribute) Schema(name schema.FrameworkIdentifier) (string, error) {
+func (g GeneratorNumberAttribute) Schema(name schema.FrameworkIdentifier, options schema.SchemaOptions) (string, error) {
 	var b bytes.Buffer
 
 	b.WriteString(fmt.Sprintf("%q: schema.NumberAttribute{\n", name))
-	b.Write(g.CustomType.Schema())
+	b.Write(options.CustomTypeSchema(g.CustomType))
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
 	b.Write(g.Description.Schema())
@@ -121,14 +122,14 @@ func (g GeneratorNumberAttribute) Schema(name schema.FrameworkIdentifier) (strin
 	return b.String(), nil
 }
 
-func (g GeneratorNumberAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorNumberAttribute) ModelField(name schema.FrameworkIdentifier, options schema.SchemaOptions) (model.Field, error) {
 	field := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: model.NumberValueType,
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		field.ValueType = customValueType
@@ -167,7 +168,7 @@ func (g GeneratorNumberAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorNumberAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorNumberAttribute) ToFromFunctions(name string, typeMapping schema.TypeMapping) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -210,6 +211,9 @@ func (g GeneratorNumberAttribute) To() (schema.ToFromConversion, error) {
 
 	return schema.ToFromConversion{
 		Default: "ValueBigFloat",
+		Type: &specschema.ElementType{
+			Number: &specschema.NumberType{},
+		},
 	}, nil
 }
 
@@ -222,5 +226,8 @@ func (g GeneratorNumberAttribute) From() (schema.ToFromConversion, error) {
 
 	return schema.ToFromConversion{
 		Default: "NumberValue",
+		Type: &specschema.ElementType{
+			Number: &specschema.NumberType{},
+		},
 	}, nil
 }
@@ -264,7 +264,7 @@ func TestGeneratorDataSourceSchemas_ModelsBytes(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			g := schema.NewGeneratorSchemas(testCase.input)
+			g := schema.NewGeneratorSchemas(testCase.input, schema.SchemaOptions{})
 			got, err := g.Models()
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
@@ -135,8 +135,8 @@ func (g GeneratorSetNestedBlock) Equal(ga schema.GeneratorBlock) bool {
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorSetNestedBlock) Schema(name schema.FrameworkIdentifier) (string, error) {
-	nestedObjectSchema, err := g.NestedBlockObject.Schema()
+func (g GeneratorSetNestedBlock) Schema(name schema.FrameworkIdentifier, options schema.SchemaOptions) (string, error) {
+	nestedObjectSchema, err := g.NestedBlockObject.Schema(options)
 
 	if err != nil {
 		return "", err
@@ -146,7 +146,7 @@ func (g GeneratorSetNestedBlock) Schema(name schema.FrameworkIdentifier) (string
 
 	b.WriteString(fmt.Sprintf("%q: schema.SetNestedBlock{\n", name))
 	b.Write(nestedObjectSchema)
-	b.Write(g.CustomType.Schema())
+	b.Write(options.CustomTypeSchema(g.CustomType))
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
 	b.Write(g.Description.Schema())
@@ -157,14 +157,14 @@ func (g GeneratorSetNestedBlock) Schema(name schema.FrameworkIdentifier) (string
 	return b.String(), nil
 }
 
-func (g GeneratorSetNestedBlock) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorSetNestedBlock) ModelField(name schema.FrameworkIdentifier, options schema.SchemaOptions) (model.Field, error) {
 	f := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: model.SetValueType,
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		f.ValueType = customValueType
@@ -310,7 +310,7 @@ func (g GeneratorSetNestedBlock) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSetNestedBlock) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorSetNestedBlock) ToFromFunctions(name string, typeMapping schema.TypeMapping) ([]byte, error) {
 	if g.NestedObject.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -329,7 +329,7 @@ func (g GeneratorSetNestedBlock) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.NestedObject.AssociatedExternalType, toFuncs, fromFuncs, typeMapping)
 
 	b, err := toFrom.Render()
 
@@ -345,7 +345,7 @@ func (g GeneratorSetNestedBlock) ToFromFunctions(name string) ([]byte, error) {
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.NestedObject.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := c.ToFromFunctions(k, typeMapping)
 
 			if err != nil {
 				return nil, err
@@ -1781,7 +1781,7 @@ my_other_validator.Validate(),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.Schema("set_nested_block")
+			got, err := testCase.input.Schema("set_nested_block", generatorschema.SchemaOptions{})
 
 			if err != nil {
 				t.Error(err)
@@ -1831,7 +1831,7 @@ func TestGeneratorSetNestedBlock_ModelField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.ModelField("set_nested_block")
+			got, err := testCase.input.ModelField("set_nested_block", generatorschema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -119,8 +119,8 @@ func (g GeneratorSingleNestedAttribute) Equal(ga schema.GeneratorAttribute) bool
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorSingleNestedAttribute) Schema(name schema.FrameworkIdentifier) (string, error) {
-	attributesSchema, err := g.Attributes.Schema()
+func (g GeneratorSingleNestedAttribute) Schema(name schema.FrameworkIdentifier, options schema.SchemaOptions) (string, error) {
+	attributesSchema, err := g.Attributes.Schema(options)
 
 	if err != nil {
 		return "", err
@@ -132,7 +132,7 @@ func (g GeneratorSingleNestedAttribute) Schema(name schema.FrameworkIdentifier)
 	b.WriteString("Attributes: map[string]schema.Attribute{")
 	b.WriteString(attributesSchema)
 	b.WriteString("\n},\n")
-	b.Write(g.CustomType.Schema())
+	b.Write(options.CustomTypeSchema(g.CustomType))
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
 	b.Write(g.Description.Schema())
@@ -143,14 +143,14 @@ func (g GeneratorSingleNestedAttribute) Schema(name schema.FrameworkIdentifier)
 	return b.String(), nil
 }
 
-func (g GeneratorSingleNestedAttribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorSingleNestedAttribute) ModelField(name schema.FrameworkIdentifier, options schema.SchemaOptions) (model.Field, error) {
 	f := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: name.ToPascalCase() + "Value",
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		f.ValueType = customValueType
@@ -229,7 +229,7 @@ func (g GeneratorSingleNestedAttribute) CustomTypeAndValue(name string) ([]byte,
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSingleNestedAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorSingleNestedAttribute) ToFromFunctions(name string, typeMapping schema.TypeMapping) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -244,7 +244,7 @@ func (g GeneratorSingleNestedAttribute) ToFromFunctions(name string) ([]byte, er
 
 	fromFuncs, _ := g.Attributes.FromFuncs()
 
-	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs, typeMapping)
 
 	b, err := toFrom.Render()
 
@@ -260,7 +260,7 @@ func (g GeneratorSingleNestedAttribute) ToFromFunctions(name string) ([]byte, er
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := c.ToFromFunctions(k, typeMapping)
 
 			if err != nil {
 				return nil, err
@@ -133,14 +133,14 @@ func (g GeneratorSingleNestedBlock) Equal(ga schema.GeneratorBlock) bool {
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorSingleNestedBlock) Schema(name schema.FrameworkIdentifier) (string, error) {
-	attributesSchema, err := g.Attributes.Schema()
+func (g GeneratorSingleNestedBlock) Schema(name schema.FrameworkIdentifier, options schema.SchemaOptions) (string, error) {
+	attributesSchema, err := g.Attributes.Schema(options)
 
 	if err != nil {
 		return "", err
 	}
 
-	blocksSchema, err := g.Blocks.Schema()
+	blocksSchema, err := g.Blocks.Schema(options)
 
 	if err != nil {
 		return "", err
@@ -159,7 +159,7 @@ func (g GeneratorSingleNestedBlock) Schema(name schema.FrameworkIdentifier) (str
 		b.WriteString(blocksSchema)
 		b.WriteString("\n},\n")
 	}
-	b.Write(g.CustomType.Schema())
+	b.Write(options.CustomTypeSchema(g.CustomType))
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
 	b.Write(g.Description.Schema())
@@ -170,14 +170,14 @@ func (g GeneratorSingleNestedBlock) Schema(name schema.FrameworkIdentifier) (str
 	return b.String(), nil
 }
 
-func (g GeneratorSingleNestedBlock) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorSingleNestedBlock) ModelField(name schema.FrameworkIdentifier, options schema.SchemaOptions) (model.Field, error) {
 	f := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: name.ToPascalCase() + "Value",
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		f.ValueType = customValueType
@@ -323,7 +323,7 @@ func (g GeneratorSingleNestedBlock) CustomTypeAndValue(name string) ([]byte, err
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorSingleNestedBlock) ToFromFunctions(name string, typeMapping schema.TypeMapping) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -338,7 +338,7 @@ func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error)
 
 	fromFuncs, _ := g.Attributes.FromFuncs()
 
-	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs)
+	toFrom := schema.NewToFromNestedObject(name, g.AssociatedExternalType, toFuncs, fromFuncs, typeMapping)
 
 	b, err := toFrom.Render()
 
@@ -354,7 +354,7 @@ func (g GeneratorSingleNestedBlock) ToFromFunctions(name string) ([]byte, error)
 	// ToFrom interface.
 	for _, k := range attributeKeys {
 		if c, ok := g.Attributes[k].(schema.ToFrom); ok {
-			b, err := c.ToFromFunctions(k)
+			b, err := c.ToFromFunctions(k, typeMapping)
 
 			if err != nil {
 				return nil, err
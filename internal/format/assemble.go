@@ -0,0 +1,410 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package format
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+)
+
+// Assemble merges the fragments of a Go file into a single formatted file. The first fragment with a package
+// clause names the package and provides the leading comments of the file, while fragments without one only hold
+// declarations, optionally preceded by imports. Declarations repeated across fragments are kept once, where they
+// first appear, along with their comments.
+//
+// The imports of the file are computed from the package names it uses, which are resolved with the imports
+// declared by the fragments first and knownImports second, so unused imports are dropped. Dot and blank imports
+// declared by the fragments are always kept.
+func Assemble(knownImports []code.Import, fragments ...[]byte) ([]byte, error) {
+	var a assembler
+
+	for i, fragment := range fragments {
+		if len(bytes.TrimSpace(fragment)) == 0 {
+			continue
+		}
+
+		if err := a.add(fragment); err != nil {
+			return nil, fmt.Errorf("fragment %d: %w", i, err)
+		}
+	}
+
+	if a.packageName == "" {
+		return nil, errors.New("no fragment has a package clause")
+	}
+
+	body := a.body.String()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package "+a.packageName+"\n"+body, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+
+	b.WriteString(a.header)
+	b.WriteString("package " + a.packageName + "\n\n")
+	b.WriteString(importDecl(a.resolveImports(usedPackageNames(file), knownImports)))
+	b.WriteString(body)
+
+	return format.Source(b.Bytes())
+}
+
+// assembler accumulates the declarations of fragments.
+type assembler struct {
+	header      string
+	packageName string
+	imports     []code.Import
+	body        strings.Builder
+	seen        map[string]bool
+}
+
+// packageClause matches a fragment starting with a package clause, after comments.
+var packageClause = regexp.MustCompile(`^(\s*(//[^\n]*|/\*(?s:.*?)\*/))*\s*package\s`)
+
+// add parses the fragment and appends its declarations which were not seen yet.
+func (a *assembler) add(fragment []byte) error {
+	src := fragment
+	hasPackage := packageClause.Match(fragment)
+
+	if !hasPackage {
+		src = append([]byte("package _\n"), fragment...)
+	}
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	offset := func(pos token.Pos) int {
+		return fset.Position(pos).Offset
+	}
+
+	if hasPackage {
+		switch a.packageName {
+		case "":
+			a.packageName = file.Name.Name
+			a.header = string(src[:offset(file.Package)])
+		case file.Name.Name:
+		default:
+			return fmt.Errorf("package %s, expected %s", file.Name.Name, a.packageName)
+		}
+	}
+
+	for _, spec := range file.Imports {
+		imp := code.Import{
+			Path: strings.Trim(spec.Path.Value, `"`),
+		}
+
+		if spec.Name != nil {
+			imp.Alias = &spec.Name.Name
+		}
+
+		a.addImport(imp)
+	}
+
+	if a.seen == nil {
+		a.seen = make(map[string]bool)
+	}
+
+	// Text between declarations, e.g. comments, is written along with the following declaration.
+	start := offset(file.Name.End())
+
+	for _, decl := range file.Decls {
+		end := lineEnd(src, offset(decl.End()))
+
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.IMPORT {
+			start = end
+			continue
+		}
+
+		a.body.WriteString(a.declText(src, decl, start, end, offset))
+
+		start = end
+	}
+
+	if rest := src[start:]; len(bytes.TrimSpace(rest)) > 0 {
+		a.body.Write(rest)
+	}
+
+	a.body.WriteString("\n")
+
+	return nil
+}
+
+// declText returns the text of the declaration from start to end, without the specs which were already seen,
+// or nothing when all of them were.
+func (a *assembler) declText(src []byte, decl ast.Decl, start, end int, offset func(token.Pos) int) string {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		key := funcKey(decl)
+
+		if key != "init" && a.seen[key] {
+			return ""
+		}
+
+		a.seen[key] = true
+	case *ast.GenDecl:
+		var duplicates [][2]int
+
+		for _, spec := range decl.Specs {
+			keys := specKeys(decl.Tok, spec, src[offset(spec.Pos()):offset(spec.End())])
+
+			seen := true
+
+			for _, key := range keys {
+				if !a.seen[key] {
+					seen = false
+				}
+
+				a.seen[key] = true
+			}
+
+			if seen {
+				specStart := spec.Pos()
+
+				if doc := specDoc(spec); doc != nil {
+					specStart = doc.Pos()
+				}
+
+				duplicates = append(duplicates, [2]int{offset(specStart), lineEnd(src, offset(spec.End()))})
+			}
+		}
+
+		if len(duplicates) == len(decl.Specs) {
+			return ""
+		}
+
+		var b strings.Builder
+
+		for _, d := range duplicates {
+			b.Write(src[start:d[0]])
+			start = d[1]
+		}
+
+		b.Write(src[start:end])
+
+		return b.String()
+	}
+
+	return string(src[start:end])
+}
+
+// addImport adds the import declared by a fragment, unless it was already declared.
+func (a *assembler) addImport(imp code.Import) {
+	for _, v := range a.imports {
+		if v.Path == imp.Path && importName(v) == importName(imp) {
+			return
+		}
+	}
+
+	a.imports = append(a.imports, imp)
+}
+
+// resolveImports returns the imports of the used package names, along with the dot and blank imports.
+func (a *assembler) resolveImports(used map[string]bool, knownImports []code.Import) []code.Import {
+	var imports []code.Import
+
+	resolved := make(map[string]bool)
+
+	for _, v := range a.imports {
+		name := importName(v)
+
+		if name == "." || name == "_" {
+			imports = append(imports, v)
+		}
+	}
+
+	for _, candidates := range [][]code.Import{a.imports, knownImports} {
+		for _, v := range candidates {
+			name := importName(v)
+
+			if used[name] && !resolved[name] {
+				imports = append(imports, v)
+				resolved[name] = true
+			}
+		}
+	}
+
+	return imports
+}
+
+// usedPackageNames returns the identifiers which qualify selectors without being declared within the file,
+// which refer to imported packages.
+func usedPackageNames(file *ast.File) map[string]bool {
+	used := make(map[string]bool)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Obj == nil {
+				used[ident.Name] = true
+			}
+		}
+
+		return true
+	})
+
+	return used
+}
+
+// importName returns the name the import is referred to by, which is the last element of its path unless aliased.
+// Major version suffixes, e.g. "/v2", are skipped.
+func importName(imp code.Import) string {
+	if imp.Alias != nil {
+		return *imp.Alias
+	}
+
+	name := path.Base(imp.Path)
+
+	if major, ok := strings.CutPrefix(name, "v"); ok && strings.Contains(imp.Path, "/") {
+		if _, err := strconv.Atoi(major); err == nil {
+			name = path.Base(path.Dir(imp.Path))
+		}
+	}
+
+	return name
+}
+
+// importDecl returns the import declaration of the imports, with the standard library grouped first.
+func importDecl(imports []code.Import) string {
+	if len(imports) == 0 {
+		return ""
+	}
+
+	var std, other []string
+
+	for _, v := range imports {
+		line := strconv.Quote(v.Path)
+
+		if v.Alias != nil {
+			line = *v.Alias + " " + line
+		}
+
+		if strings.Contains(strings.Split(v.Path, "/")[0], ".") {
+			other = append(other, line)
+		} else {
+			std = append(std, line)
+		}
+	}
+
+	sort.Strings(std)
+	sort.Strings(other)
+
+	var b strings.Builder
+
+	b.WriteString("import (\n")
+
+	for _, group := range [][]string{std, other} {
+		if len(group) == 0 {
+			continue
+		}
+
+		if b.Len() > len("import (\n") {
+			b.WriteString("\n")
+		}
+
+		for _, line := range group {
+			b.WriteString(line + "\n")
+		}
+	}
+
+	b.WriteString(")\n\n")
+
+	return b.String()
+}
+
+// funcKey returns the key identifying the function, qualified by its receiver type for methods.
+func funcKey(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return decl.Name.Name
+	}
+
+	recv := decl.Recv.List[0].Type
+
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+
+	switch expr := recv.(type) {
+	case *ast.IndexExpr:
+		recv = expr.X
+	case *ast.IndexListExpr:
+		recv = expr.X
+	}
+
+	if ident, ok := recv.(*ast.Ident); ok {
+		return ident.Name + "." + decl.Name.Name
+	}
+
+	return decl.Name.Name
+}
+
+// specKeys returns the keys identifying the names declared by the spec. Blank identifiers are identified
+// by the text of the spec, so only identical ones are duplicates.
+func specKeys(tok token.Token, spec ast.Spec, text []byte) []string {
+	var names []*ast.Ident
+
+	switch spec := spec.(type) {
+	case *ast.TypeSpec:
+		names = []*ast.Ident{spec.Name}
+	case *ast.ValueSpec:
+		names = spec.Names
+	}
+
+	keys := make([]string, 0, len(names))
+
+	for _, name := range names {
+		if name.Name == "_" {
+			keys = append(keys, tok.String()+" _ "+string(text))
+			continue
+		}
+
+		keys = append(keys, name.Name)
+	}
+
+	return keys
+}
+
+// specDoc returns the doc comment of the spec.
+func specDoc(spec ast.Spec) *ast.CommentGroup {
+	switch spec := spec.(type) {
+	case *ast.TypeSpec:
+		return spec.Doc
+	case *ast.ValueSpec:
+		return spec.Doc
+	}
+
+	return nil
+}
+
+// lineEnd returns the offset following the end of the line at offset, when the rest of the line only holds
+// a comment, so the comment belongs to what precedes it.
+func lineEnd(src []byte, offset int) int {
+	rest := src[offset:]
+
+	if i := bytes.IndexByte(rest, '\n'); i >= 0 {
+		rest = rest[:i+1]
+	}
+
+	trimmed := bytes.TrimSpace(rest)
+
+	if len(trimmed) == 0 || bytes.HasPrefix(trimmed, []byte("//")) {
+		return offset + len(rest)
+	}
+
+	return offset
+}
@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package format
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAssemble(t *testing.T) {
+	t.Parallel()
+
+	knownImports := []code.Import{
+		{Path: "fmt"},
+		{Path: "strings"},
+		{Path: "github.com/hashicorp/terraform-plugin-framework/types"},
+		{Path: "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"},
+	}
+
+	testCases := map[string]struct {
+		fragments     []string
+		expected      string
+		expectedError error
+	}{
+		"fragments": {
+			fragments: []string{
+				`// Code generated DO NOT EDIT.
+
+package example
+
+import (
+	"context"
+	"os"
+)
+
+// ExampleSchema returns the schema.
+func ExampleSchema(ctx context.Context) string {
+	return "schema"
+}
+`,
+				`
+/* The resource. */
+var (
+	_ fmt.Stringer = &exampleResource{}
+)
+
+type exampleResource struct {
+	value types.String // the value
+}
+
+func (r *exampleResource) String() string {
+	return fmt.Sprintf("%s", r.value)
+}
+`,
+				`
+func (r *exampleResource) Wait(conf *retry.StateChangeConf) error {
+	return nil
+}
+
+// ExampleSchema is declared twice.
+func ExampleSchema(ctx context.Context) string {
+	return "duplicate"
+}
+`,
+			},
+			expected: `// Code generated DO NOT EDIT.
+
+package example
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// ExampleSchema returns the schema.
+func ExampleSchema(ctx context.Context) string {
+	return "schema"
+}
+
+/* The resource. */
+var (
+	_ fmt.Stringer = &exampleResource{}
+)
+
+type exampleResource struct {
+	value types.String // the value
+}
+
+func (r *exampleResource) String() string {
+	return fmt.Sprintf("%s", r.value)
+}
+
+func (r *exampleResource) Wait(conf *retry.StateChangeConf) error {
+	return nil
+}
+`,
+		},
+		"declared-imports": {
+			fragments: []string{
+				`package example_test
+
+import (
+	"strings"
+	. "example.com/internal/test"
+	_ "example.com/internal/driver"
+	sdkstrings "example.com/strings"
+)
+
+func TestExample(t *testing.T) {
+	PreCheck(t)
+	sdkstrings.Join()
+}
+`,
+			},
+			expected: `package example_test
+
+import (
+	_ "example.com/internal/driver"
+	. "example.com/internal/test"
+	sdkstrings "example.com/strings"
+)
+
+func TestExample(t *testing.T) {
+	PreCheck(t)
+	sdkstrings.Join()
+}
+`,
+		},
+		"dot-import-used": {
+			fragments: []string{
+				`package example_test
+
+import (
+	"os"
+	"testing"
+
+	"example.com/helper/acctest"
+	. "example.com/internal/test"
+)
+`,
+				`
+func TestExample(t *testing.T) {
+	name := acctest.RandString(5)
+
+	PreCheck(t)
+	Run(t, ProviderFactories, name)
+}
+`,
+			},
+			expected: `package example_test
+
+import (
+	"testing"
+
+	"example.com/helper/acctest"
+	. "example.com/internal/test"
+)
+
+func TestExample(t *testing.T) {
+	name := acctest.RandString(5)
+
+	PreCheck(t)
+	Run(t, ProviderFactories, name)
+}
+`,
+		},
+		"declared-imports-override-known-imports": {
+			fragments: []string{
+				`package example
+
+import (
+	"example.com/types"
+)
+`,
+				`
+var value types.String
+`,
+			},
+			expected: `package example
+
+import (
+	"example.com/types"
+)
+
+var value types.String
+`,
+		},
+		"duplicate-specs": {
+			fragments: []string{
+				`package example
+
+const (
+	// first is declared once.
+	first = 1
+	second = 2
+)
+
+type value struct{}
+`,
+				`
+const (
+	// first is declared twice.
+	first = 1
+	third = 3
+)
+
+var _ = value{}
+
+type (
+	value struct{}
+)
+
+var _ = value{}
+
+func init() {}
+`,
+				`
+func init() {}
+`,
+			},
+			expected: `package example
+
+const (
+	// first is declared once.
+	first  = 1
+	second = 2
+)
+
+type value struct{}
+
+const (
+	third = 3
+)
+
+var _ = value{}
+
+func init() {}
+
+func init() {}
+`,
+		},
+		"variable-shadowing-package": {
+			fragments: []string{
+				`package example
+
+func example(strings []string) int {
+	return strings.Len()
+}
+`,
+			},
+			expected: `package example
+
+func example(strings []string) int {
+	return strings.Len()
+}
+`,
+		},
+		"package-mismatch": {
+			fragments: []string{
+				"package example\n",
+				"package other\n",
+			},
+			expectedError: fmt.Errorf("fragment 1: %w", errors.New("package other, expected example")),
+		},
+		"no-package": {
+			fragments: []string{
+				"func example() {}\n",
+			},
+			expectedError: errors.New("no fragment has a package clause"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fragments := make([][]byte, 0, len(testCase.fragments))
+
+			for _, v := range testCase.fragments {
+				fragments = append(fragments, []byte(v))
+			}
+
+			got, err := Assemble(knownImports, fragments...)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+// Equate errors based on their messages.
+var equateErrorMessage = cmp.Comparer(func(x, y error) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+
+	return x.Error() == y.Error()
+})
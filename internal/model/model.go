@@ -10,6 +10,7 @@ import (
 
 const (
 	BoolValueType    = "types.Bool"
+	Float32ValueType = "types.Float32"
 	Float64ValueType = "types.Float64"
 	Int64ValueType   = "types.Int64"
 	Int32ValueType   = "types.Int32"
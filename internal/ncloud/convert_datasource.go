@@ -2,9 +2,11 @@ package ncloud
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 )
 
 // generate converter that convert openapi.json schema to terraform type
@@ -39,7 +41,13 @@ func Gen_ConvertOAStoTFTypes_Datasource(data datasource.Attributes) (string, str
 				dto.%[1]s = types.Int64Value(data["%[2]s"].(int64))
 			}`, util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
 			m = m + fmt.Sprintf("%[1]s         types.Int64 `tfsdk:\"%[2]s\"`", util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-		} else if val.Float64 != nil || val.Float32 != nil {
+		} else if val.Float32 != nil {
+			s = s + fmt.Sprintf(`
+			if data["%[2]s"] != nil {
+				dto.%[1]s = types.Float32Value(float32(data["%[2]s"].(float64)))
+			}`, util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
+			m = m + fmt.Sprintf("%[1]s         types.Float32 `tfsdk:\"%[2]s\"`", util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
+		} else if val.Float64 != nil {
 			s = s + fmt.Sprintf(`
 			if data["%[2]s"] != nil {
 				dto.%[1]s = types.Float64Value(data["%[2]s"].(float64))
@@ -51,6 +59,7 @@ func Gen_ConvertOAStoTFTypes_Datasource(data datasource.Attributes) (string, str
 			} else if val.List.ElementType.Bool != nil {
 				s = s + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.BoolType},`, n) + "\n"
 			}
+			m = m + fmt.Sprintf("%[1]s         types.List `tfsdk:\"%[2]s\"`", util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
 		} else if val.ListNested != nil {
 			s = s + fmt.Sprintf(`
 			if data["%[2]s"] != nil {
@@ -59,7 +68,7 @@ func Gen_ConvertOAStoTFTypes_Datasource(data datasource.Attributes) (string, str
 					%[3]s
 				}}.ElementType(), temp%[1]s)
 			}`, CamelToPascalCase(n), PascalToSnakeCase(n), GenArray_Datasource(val.ListNested.NestedObject.Attributes, n)) + "\n"
-			m = m + fmt.Sprintf("%[1]s         types.List `tfsdk:\"%[2]s\"`", CamelToPascalCase(n), PascalToSnakeCase(n)) + "\n"
+			m = m + fmt.Sprintf("%[1]s         types.List `tfsdk:\"%[2]s\"`", util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
 		} else if val.SingleNested != nil {
 			s = s + fmt.Sprintf(`
 			if data["%[2]s"] != nil {
@@ -156,3 +165,160 @@ func GenObject_Datasource(d datasource.Attributes, pName string) string {
 	}
 	return s
 }
+
+// MakeDataSourceRefreshFromResponse generates the code filling postPlan from the object returned by the read operation.
+// Every attribute held by the model is converted into its schema type, including lists and nested objects.
+func MakeDataSourceRefreshFromResponse(attrs datasource.Attributes, dataSourceName string) string {
+	var s strings.Builder
+
+	for _, val := range attrs {
+		valueType, expr, ok := dataSourceModelAttrType(val)
+		if !ok {
+			continue
+		}
+
+		s.WriteString(fmt.Sprintf(`
+	postPlan.%[3]s, err = ncloudsdk.Convert[%[4]s](response.%[2]s.Attributes()["%[1]s"], %[5]s)
+	if err != nil {
+		diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert %[1]s: %%v", err))
+		return
+	}
+`, PascalToSnakeCase(val.Name), util.ToPascalCase(dataSourceName), util.ToPascalCase(val.Name), valueType, expr))
+	}
+
+	return s.String()
+}
+
+// dataSourceModelAttrType returns the type of the model field rendered by Gen_ConvertOAStoTFTypes_Datasource,
+// along with the expression of its framework type.
+func dataSourceModelAttrType(val datasource.Attribute) (string, string, bool) {
+	var valueType string
+
+	switch {
+	case val.String != nil:
+		valueType = "types.String"
+	case val.Bool != nil:
+		valueType = "types.Bool"
+	case val.Int32 != nil:
+		valueType = "types.Int32"
+	case val.Int64 != nil:
+		valueType = "types.Int64"
+	case val.Float32 != nil:
+		valueType = "types.Float32"
+	case val.Float64 != nil:
+		valueType = "types.Float64"
+	case val.List != nil || val.ListNested != nil:
+		valueType = "types.List"
+	case val.SingleNested != nil:
+		valueType = "types.Object"
+	default:
+		return "", "", false
+	}
+
+	expr, ok := dataSourceAttrTypeExpr(val)
+
+	return valueType, expr, ok
+}
+
+// dataSourceAttrTypeExpr returns the expression of the framework type of any data source attribute.
+func dataSourceAttrTypeExpr(val datasource.Attribute) (string, bool) {
+	switch {
+	case val.String != nil:
+		return "types.StringType", true
+	case val.Bool != nil:
+		return "types.BoolType", true
+	case val.Int32 != nil:
+		return "types.Int32Type", true
+	case val.Int64 != nil:
+		return "types.Int64Type", true
+	case val.Float32 != nil:
+		return "types.Float32Type", true
+	case val.Float64 != nil:
+		return "types.Float64Type", true
+	case val.Number != nil:
+		return "types.NumberType", true
+	case val.List != nil:
+		return fmt.Sprintf("types.ListType{ElemType: %s}", elementTypeExpr(val.List.ElementType)), true
+	case val.Set != nil:
+		return fmt.Sprintf("types.SetType{ElemType: %s}", elementTypeExpr(val.Set.ElementType)), true
+	case val.Map != nil:
+		return fmt.Sprintf("types.MapType{ElemType: %s}", elementTypeExpr(val.Map.ElementType)), true
+	case val.Object != nil:
+		return objectAttributeTypesExpr(val.Object.AttributeTypes), true
+	case val.ListNested != nil:
+		return fmt.Sprintf("types.ListType{ElemType: %s}", nestedObjectTypeExpr(val.ListNested.NestedObject.Attributes)), true
+	case val.SetNested != nil:
+		return fmt.Sprintf("types.SetType{ElemType: %s}", nestedObjectTypeExpr(val.SetNested.NestedObject.Attributes)), true
+	case val.MapNested != nil:
+		return fmt.Sprintf("types.MapType{ElemType: %s}", nestedObjectTypeExpr(val.MapNested.NestedObject.Attributes)), true
+	case val.SingleNested != nil:
+		return nestedObjectTypeExpr(val.SingleNested.Attributes), true
+	}
+
+	return "", false
+}
+
+func nestedObjectTypeExpr(attrs datasource.Attributes) string {
+	var s strings.Builder
+
+	s.WriteString("types.ObjectType{AttrTypes: map[string]attr.Type{\n")
+	for _, val := range attrs {
+		if expr, ok := dataSourceAttrTypeExpr(val); ok {
+			s.WriteString(fmt.Sprintf("%q: %s,\n", val.Name, expr))
+		}
+	}
+	s.WriteString("}}")
+
+	return s.String()
+}
+
+func elementTypeExpr(e schema.ElementType) string {
+	switch {
+	case e.Bool != nil:
+		return "types.BoolType"
+	case e.Float32 != nil:
+		return "types.Float32Type"
+	case e.Float64 != nil:
+		return "types.Float64Type"
+	case e.Int32 != nil:
+		return "types.Int32Type"
+	case e.Int64 != nil:
+		return "types.Int64Type"
+	case e.Number != nil:
+		return "types.NumberType"
+	case e.List != nil:
+		return fmt.Sprintf("types.ListType{ElemType: %s}", elementTypeExpr(e.List.ElementType))
+	case e.Set != nil:
+		return fmt.Sprintf("types.SetType{ElemType: %s}", elementTypeExpr(e.Set.ElementType))
+	case e.Map != nil:
+		return fmt.Sprintf("types.MapType{ElemType: %s}", elementTypeExpr(e.Map.ElementType))
+	case e.Object != nil:
+		return objectAttributeTypesExpr(e.Object.AttributeTypes)
+	default:
+		return "types.StringType"
+	}
+}
+
+func objectAttributeTypesExpr(attrTypes schema.ObjectAttributeTypes) string {
+	var s strings.Builder
+
+	s.WriteString("types.ObjectType{AttrTypes: map[string]attr.Type{\n")
+	for _, val := range attrTypes {
+		s.WriteString(fmt.Sprintf("%q: %s,\n", val.Name, elementTypeExpr(schema.ElementType{
+			Bool:    val.Bool,
+			Float32: val.Float32,
+			Float64: val.Float64,
+			Int32:   val.Int32,
+			Int64:   val.Int64,
+			List:    val.List,
+			Map:     val.Map,
+			Number:  val.Number,
+			Object:  val.Object,
+			Set:     val.Set,
+			String:  val.String,
+		})))
+	}
+	s.WriteString("}}")
+
+	return s.String()
+}
@@ -9,154 +9,103 @@ import (
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
 )
 
-// generate converter that convert openapi.json schema to terraform type
-func Gen_ConvertOAStoTFTypes_Resource(data resource.Attributes) (string, string, error) {
-	var s string
+// MakeResourceModel renders the fields of the resource model, one for each attribute with a model type.
+func MakeResourceModel(data resource.Attributes) string {
 	var m string
 
 	for _, val := range data {
-		n := val.Name
-
-		if val.String != nil {
-			s = s + fmt.Sprintf(`
-			if data["%[2]s"] != nil {
-				dto.%[1]s = types.StringValue(data["%[2]s"].(string))
-			}`, util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-			m = m + fmt.Sprintf("%[1]s         types.String `tfsdk:\"%[2]s\"`", util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-		} else if val.Bool != nil {
-			s = s + fmt.Sprintf(`
-			if data["%[2]s"] != nil {
-				dto.%[1]s = types.BoolValue(data["%[2]s"].(bool))
-			}`, util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-			m = m + fmt.Sprintf("%[1]s         types.Bool `tfsdk:\"%[2]s\"`", util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-		} else if val.Int32 != nil {
-			s = s + fmt.Sprintf(`
-			if data["%[2]s"] != nil {
-				dto.%[1]s = types.Int32Value(data["%[2]s"].(int32))
-			}`, util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-			m = m + fmt.Sprintf("%[1]s         types.Int32 `tfsdk:\"%[2]s\"`", util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-		} else if val.Int64 != nil {
-			s = s + fmt.Sprintf(`
-			if data["%[2]s"] != nil {
-				dto.%[1]s = types.Int64Value(data["%[2]s"].(int64))
-			}`, util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-			m = m + fmt.Sprintf("%[1]s         types.Int64 `tfsdk:\"%[2]s\"`", util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-		} else if val.Float64 != nil || val.Float32 != nil {
-			s = s + fmt.Sprintf(`
-			if data["%[2]s"] != nil {
-				dto.%[1]s = types.Float64Value(data["%[2]s"].(float64))
-			}`, util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-			m = m + fmt.Sprintf("%[1]s         types.Float64 `tfsdk:\"%[2]s\"`", util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-		} else if val.List != nil {
-			if val.List.ElementType.String != nil {
-				s = s + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.StringType},`, n) + "\n"
-			} else if val.List.ElementType.Bool != nil {
-				s = s + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.BoolType},`, n) + "\n"
-			}
-		} else if val.ListNested != nil {
-			s = s + fmt.Sprintf(`
-			if data["%[2]s"] != nil {
-				temp%[1]s := data["%[2]s"].([]interface{})
-				dto.%[1]s = diagOff(types.ListValueFrom, context.TODO(), types.ListType{ElemType:
-					%[3]s
-				}}.ElementType(), temp%[1]s)
-			}`, CamelToPascalCase(n), PascalToSnakeCase(n), GenArray_Resource(val.ListNested.NestedObject.Attributes, n)) + "\n"
-			m = m + fmt.Sprintf("%[1]s         types.List `tfsdk:\"%[2]s\"`", CamelToPascalCase(n), PascalToSnakeCase(n)) + "\n"
-		} else if val.SingleNested != nil {
-			s = s + fmt.Sprintf(`
-			if data["%[2]s"] != nil {
-				temp%[1]s := data["%[2]s"].(map[string]interface{})
-				convertedTemp%[1]s, err := convertMapToObject(context.TODO(), temp%[1]s)
-				if err != nil {
-					fmt.Println("ConvertMapToObject Error")
-				}
-
-				dto.%[1]s = diagOff(types.ObjectValueFrom, context.TODO(), types.ObjectType{AttrTypes: map[string]attr.Type{
-					%[3]s
-				}}.AttributeTypes(), convertedTemp%[1]s)
-			}`, CamelToPascalCase(n), PascalToSnakeCase(n), GenObject_Resource(val.SingleNested.Attributes, n)) + "\n"
-			m = m + fmt.Sprintf("%[1]s         types.Object `tfsdk:\"%[2]s\"`", util.ToPascalCase(n), PascalToSnakeCase(n)) + "\n"
+		valueType, _, ok := resourceModelAttrType(val)
+		if !ok {
+			continue
 		}
 
+		m = m + fmt.Sprintf("%[1]s         %[3]s `tfsdk:\"%[2]s\"`", util.ToPascalCase(val.Name), PascalToSnakeCase(val.Name), valueType) + "\n"
 	}
 
-	return s, m, nil
+	return m
 }
 
-func GenArray_Resource(d resource.Attributes, pName string) string {
-	var r string
-	var s string
-	var t string
-
-	for _, val := range d {
-		n := val.Name
-
-		if val.String != nil {
-			t = t + fmt.Sprintf(`"%[1]s": types.StringType,`, n) + "\n"
-		} else if val.Bool != nil {
-			t = t + fmt.Sprintf(`"%[1]s": types.BoolType,`, n) + "\n"
-		} else if val.Int32 != nil {
-			t = t + fmt.Sprintf(`"%[1]s": types.Int32Type,`, n) + "\n"
-		} else if val.Int64 != nil {
-			t = t + fmt.Sprintf(`"%[1]s": types.Int64Type,`, n) + "\n"
-		} else if val.Float32 != nil {
-			t = t + fmt.Sprintf(`"%[1]s": types.Float32Type,`, n) + "\n"
-		} else if val.Float64 != nil {
-			t = t + fmt.Sprintf(`"%[1]s": types.Float64Type,`, n) + "\n"
-		} else if val.SingleNested != nil {
-			s = s + fmt.Sprintf(`
-			"%[1]s": types.ObjectType{AttrTypes: map[string]attr.Type{
-				%[2]s
-			}},`, n, GenObject_Resource(val.SingleNested.Attributes, n)) + "\n"
-		}
+// resourceModelAttrType returns the type of the model field rendered by MakeResourceModel,
+// along with the expression of its framework type.
+func resourceModelAttrType(val resource.Attribute) (string, string, bool) {
+	var valueType string
+
+	switch {
+	case val.String != nil:
+		valueType = "types.String"
+	case val.Bool != nil:
+		valueType = "types.Bool"
+	case val.Int32 != nil:
+		valueType = "types.Int32"
+	case val.Int64 != nil:
+		valueType = "types.Int64"
+	case val.Float32 != nil:
+		valueType = "types.Float32"
+	case val.Float64 != nil:
+		valueType = "types.Float64"
+	case val.List != nil || val.ListNested != nil:
+		valueType = "types.List"
+	case val.SingleNested != nil:
+		valueType = "types.Object"
+	default:
+		return "", "", false
 	}
 
-	r = r + fmt.Sprintf(`
-	types.ObjectType{AttrTypes: map[string]attr.Type{
-		%[1]s
-		%[2]s
-	},`, s, t)
+	expr, ok := resourceAttrTypeExpr(val)
 
-	return r
+	return valueType, expr, ok
 }
 
-func GenObject_Resource(d resource.Attributes, pName string) string {
-	var s string
-
-	for _, val := range d {
-		n := val.Name
-
-		if val.String != nil {
-			s = s + fmt.Sprintf(`"%[1]s": types.StringType,`, n) + "\n"
-		} else if val.Bool != nil {
-			s = s + fmt.Sprintf(`"%[1]s": types.BoolType,`, n) + "\n"
-		} else if val.Int32 != nil {
-			s = s + fmt.Sprintf(`"%[1]s": types.Int32Type,`, n) + "\n"
-		} else if val.Int64 != nil {
-			s = s + fmt.Sprintf(`"%[1]s": types.Int64Type,`, n) + "\n"
-		} else if val.Float32 != nil {
-			s = s + fmt.Sprintf(`"%[1]s": types.Float32Type,`, n) + "\n"
-		} else if val.Float64 != nil {
-			s = s + fmt.Sprintf(`"%[1]s": types.Float64Type,`, n) + "\n"
-		} else if val.List != nil {
-			if val.List.ElementType.String != nil {
-				s = s + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.StringType},`, n) + "\n"
-			} else if val.List.ElementType.Bool != nil {
-				s = s + fmt.Sprintf(`"%[1]s": types.ListType{ElemType: types.BoolType},`, n) + "\n"
-			}
-		} else if val.ListNested != nil {
-			s = s + fmt.Sprintf(`
-			"%[1]s": types.ListType{ElemType:
-				%[2]s
-			}},`, n, GenArray_Resource(val.ListNested.NestedObject.Attributes, n)) + "\n"
-		} else if val.SingleNested != nil {
-			s = s + fmt.Sprintf(`
-			"%[1]s": types.ObjectType{AttrTypes: map[string]attr.Type{
-				%[2]s
-			}},`, n, GenObject_Resource(val.SingleNested.Attributes, n)) + "\n"
+// resourceAttrTypeExpr returns the expression of the framework type of any resource attribute.
+func resourceAttrTypeExpr(val resource.Attribute) (string, bool) {
+	switch {
+	case val.String != nil:
+		return "types.StringType", true
+	case val.Bool != nil:
+		return "types.BoolType", true
+	case val.Int32 != nil:
+		return "types.Int32Type", true
+	case val.Int64 != nil:
+		return "types.Int64Type", true
+	case val.Float32 != nil:
+		return "types.Float32Type", true
+	case val.Float64 != nil:
+		return "types.Float64Type", true
+	case val.Number != nil:
+		return "types.NumberType", true
+	case val.List != nil:
+		return fmt.Sprintf("types.ListType{ElemType: %s}", elementTypeExpr(val.List.ElementType)), true
+	case val.Set != nil:
+		return fmt.Sprintf("types.SetType{ElemType: %s}", elementTypeExpr(val.Set.ElementType)), true
+	case val.Map != nil:
+		return fmt.Sprintf("types.MapType{ElemType: %s}", elementTypeExpr(val.Map.ElementType)), true
+	case val.Object != nil:
+		return objectAttributeTypesExpr(val.Object.AttributeTypes), true
+	case val.ListNested != nil:
+		return fmt.Sprintf("types.ListType{ElemType: %s}", resourceNestedObjectTypeExpr(val.ListNested.NestedObject.Attributes)), true
+	case val.SetNested != nil:
+		return fmt.Sprintf("types.SetType{ElemType: %s}", resourceNestedObjectTypeExpr(val.SetNested.NestedObject.Attributes)), true
+	case val.MapNested != nil:
+		return fmt.Sprintf("types.MapType{ElemType: %s}", resourceNestedObjectTypeExpr(val.MapNested.NestedObject.Attributes)), true
+	case val.SingleNested != nil:
+		return resourceNestedObjectTypeExpr(val.SingleNested.Attributes), true
+	}
+
+	return "", false
+}
+
+func resourceNestedObjectTypeExpr(attrs resource.Attributes) string {
+	var s strings.Builder
+
+	s.WriteString("types.ObjectType{AttrTypes: map[string]attr.Type{\n")
+	for _, val := range attrs {
+		if expr, ok := resourceAttrTypeExpr(val); ok {
+			s.WriteString(fmt.Sprintf("%q: %s,\n", val.Name, expr))
 		}
 	}
-	return s
+	s.WriteString("}}")
+
+	return s.String()
 }
 
 func PascalToSnakeCase(s string) string {
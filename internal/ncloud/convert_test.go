@@ -7,6 +7,10 @@ import (
 	"testing"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -104,3 +108,103 @@ func convertToFrameworkTypes(data map[string]interface{}) *Model {
 
 	return &dto
 }
+
+func TestDataSourceAttrTypeExpr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute datasource.Attribute
+		expected  string
+	}{
+		"string": {
+			attribute: datasource.Attribute{Name: "name", String: &datasource.StringAttribute{}},
+			expected:  "types.StringType",
+		},
+		"list": {
+			attribute: datasource.Attribute{Name: "tags", List: &datasource.ListAttribute{
+				ElementType: schema.ElementType{Int64: &schema.Int64Type{}},
+			}},
+			expected: "types.ListType{ElemType: types.Int64Type}",
+		},
+		"list-nested": {
+			attribute: datasource.Attribute{Name: "stages", ListNested: &datasource.ListNestedAttribute{
+				NestedObject: datasource.NestedAttributeObject{
+					Attributes: datasource.Attributes{
+						{Name: "stage_name", String: &datasource.StringAttribute{}},
+						{Name: "owner", SingleNested: &datasource.SingleNestedAttribute{
+							Attributes: datasource.Attributes{
+								{Name: "owner_id", String: &datasource.StringAttribute{}},
+							},
+						}},
+					},
+				},
+			}},
+			expected: "types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{\n" +
+				"\"stage_name\": types.StringType,\n" +
+				"\"owner\": types.ObjectType{AttrTypes: map[string]attr.Type{\n\"owner_id\": types.StringType,\n}},\n" +
+				"}}}",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := dataSourceAttrTypeExpr(testCase.attribute)
+			if !ok {
+				t.Fatalf("expected the type of %s to be supported", testCase.attribute.Name)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceModelListAttribute(t *testing.T) {
+	t.Parallel()
+
+	model := MakeResourceModel(resource.Attributes{
+		{Name: "tags", List: &resource.ListAttribute{
+			ElementType: schema.ElementType{String: &schema.StringType{}},
+		}},
+	})
+
+	expected := "Tags         types.List `tfsdk:\"tags\"`\n"
+
+	if diff := cmp.Diff(model, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestMakeRefreshFromResponse(t *testing.T) {
+	t.Parallel()
+
+	got := MakeRefreshFromResponse(resource.Attributes{
+		{Name: "product_id", Int64: &resource.Int64Attribute{}},
+		{Name: "tags", List: &resource.ListAttribute{
+			ElementType: schema.ElementType{String: &schema.StringType{}},
+		}},
+	}, "product")
+
+	expected := `
+	postPlan.ProductId, err = ncloudsdk.Convert[types.Int64](ncloudsdk.AttributeAt(response.Product, "product_id"), types.Int64Type)
+	if err != nil {
+		diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert product_id: %v", err))
+		return false
+	}
+
+	postPlan.Tags, err = ncloudsdk.Convert[types.List](ncloudsdk.AttributeAt(response.Product, "tags"), types.ListType{ElemType: types.StringType})
+	if err != nil {
+		diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert tags: %v", err))
+		return false
+	}
+`
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
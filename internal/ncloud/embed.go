@@ -31,6 +31,9 @@ var WaitTemplate string
 //go:embed templates/test_resource.go.tpl
 var TestTemplate string
 
+//go:embed templates/mock_resource.go.tpl
+var MockTemplate string
+
 //go:embed templates/import.go.tpl
 var ImportStateTemplate string
 
@@ -48,3 +51,21 @@ var RefreshTemplateDataSource string
 
 //go:embed templates/test_datasource.go.tpl
 var TestTemplateDataSource string
+
+//go:embed templates/request.go.tpl
+var RequestTemplate string
+
+//go:embed templates/sdk_client.go.tpl
+var SDKClientTemplate string
+
+//go:embed templates/sdk_operation.go.tpl
+var SDKOperationTemplate string
+
+//go:embed templates/sdk_convert.go.tpl
+var SDKConvertTemplate string
+
+//go:embed templates/sdk_factory.go.tpl
+var SDKFactoryTemplate string
+
+//go:embed templates/registry.go.tpl
+var RegistryTemplate string
@@ -0,0 +1,73 @@
+package ncloud
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+)
+
+// Sites of NCloud accepted as keys of the endpoints field of the provider.
+var endpointSites = map[string]bool{
+	"public": true,
+	"fin":    true,
+	"gov":    true,
+}
+
+// makeEndpoints returns the ncloudsdk.Endpoints literal of the provider, from which generated code picks the endpoint
+// of the configured site and region at runtime.
+func makeEndpoints(p *util.NcloudProvider) (string, error) {
+	if p == nil || p.Endpoint == "" {
+		return "", fmt.Errorf("provider: endpoint is required")
+	}
+
+	var b strings.Builder
+
+	b.WriteString("ncloudsdk.Endpoints{\n")
+	b.WriteString(fmt.Sprintf("Default: %q,\n", p.Endpoint))
+
+	if len(p.Endpoints) > 0 {
+		b.WriteString("Sites: map[string]map[string]string{\n")
+
+		for _, site := range sortedKeys(p.Endpoints) {
+			if !endpointSites[site] {
+				return "", fmt.Errorf("provider: endpoints: unknown site %q, expected public, fin or gov", site)
+			}
+
+			regions := p.Endpoints[site]
+			if len(regions) == 0 {
+				return "", fmt.Errorf("provider: endpoints.%s: at least one region is required", site)
+			}
+
+			b.WriteString(fmt.Sprintf("%q: {\n", site))
+
+			for _, region := range sortedKeys(regions) {
+				if regions[region] == "" {
+					return "", fmt.Errorf("provider: endpoints.%s.%s: endpoint is empty", site, region)
+				}
+
+				b.WriteString(fmt.Sprintf("%q: %q,\n", region, regions[region]))
+			}
+
+			b.WriteString("},\n")
+		}
+
+		b.WriteString("},\n")
+	}
+
+	b.WriteString("}")
+
+	return b.String(), nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,96 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMakeEndpoints(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		provider      *util.NcloudProvider
+		expected      string
+		expectedError bool
+	}{
+		"default": {
+			provider: &util.NcloudProvider{Endpoint: "https://apigateway.apigw.ntruss.com/api/v1"},
+			expected: `ncloudsdk.Endpoints{
+Default: "https://apigateway.apigw.ntruss.com/api/v1",
+}`,
+		},
+		"sites-and-regions": {
+			provider: &util.NcloudProvider{
+				Endpoint: "https://apigateway.apigw.ntruss.com/api/v1",
+				Endpoints: map[string]map[string]string{
+					"public": {"SGN": "https://apigateway.apigw.sgn.ntruss.com/api/v1", "KR": "https://apigateway.apigw.ntruss.com/api/v1"},
+					"fin":    {"default": "https://apigateway.apigw.fin-ntruss.com/api/v1"},
+				},
+			},
+			expected: `ncloudsdk.Endpoints{
+Default: "https://apigateway.apigw.ntruss.com/api/v1",
+Sites: map[string]map[string]string{
+"fin": {
+"default": "https://apigateway.apigw.fin-ntruss.com/api/v1",
+},
+"public": {
+"KR": "https://apigateway.apigw.ntruss.com/api/v1",
+"SGN": "https://apigateway.apigw.sgn.ntruss.com/api/v1",
+},
+},
+}`,
+		},
+		"missing-endpoint": {
+			provider:      &util.NcloudProvider{},
+			expectedError: true,
+		},
+		"unknown-site": {
+			provider: &util.NcloudProvider{
+				Endpoint:  "https://apigateway.apigw.ntruss.com/api/v1",
+				Endpoints: map[string]map[string]string{"private": {"KR": "https://example.com"}},
+			},
+			expectedError: true,
+		},
+		"empty-site": {
+			provider: &util.NcloudProvider{
+				Endpoint:  "https://apigateway.apigw.ntruss.com/api/v1",
+				Endpoints: map[string]map[string]string{"gov": {}},
+			},
+			expectedError: true,
+		},
+		"empty-region-endpoint": {
+			provider: &util.NcloudProvider{
+				Endpoint:  "https://apigateway.apigw.ntruss.com/api/v1",
+				Endpoints: map[string]map[string]string{"gov": {"KR": ""}},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := makeEndpoints(testCase.provider)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
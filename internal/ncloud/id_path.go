@@ -0,0 +1,72 @@
+package ncloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+)
+
+// IDPath is the path to a value of a decoded response, made of object keys (string) and array indices (int).
+type IDPath []interface{}
+
+// ParseIDPath parses a path expression such as product.product_id or serverInstanceList[0].serverInstanceNo.
+// Keys written in snake_case are converted into camelCase, the way responses name them. Other keys are kept as is.
+func ParseIDPath(expr string) (IDPath, error) {
+	if expr == "" {
+		return nil, fmt.Errorf("empty path expression")
+	}
+
+	var p IDPath
+
+	for _, segment := range strings.Split(expr, ".") {
+		key := segment
+		indices := ""
+
+		if start := strings.Index(segment, "["); start != -1 {
+			key, indices = segment[:start], segment[start:]
+		}
+
+		if key == "" {
+			return nil, fmt.Errorf("%q: missing key before %q", expr, segment)
+		}
+
+		if strings.Contains(key, "_") {
+			key = util.ToCamelCase(key)
+		}
+		p = append(p, key)
+
+		for indices != "" {
+			end := strings.Index(indices, "]")
+			if !strings.HasPrefix(indices, "[") || end == -1 {
+				return nil, fmt.Errorf("%q: malformed index in %q", expr, segment)
+			}
+
+			index, err := strconv.Atoi(indices[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("%q: index %q is not a non-negative integer", expr, indices[1:end])
+			}
+			p = append(p, index)
+
+			indices = indices[end+1:]
+		}
+	}
+
+	return p, nil
+}
+
+// Args returns the steps of the path as arguments of a generated call, e.g. "serverInstanceList", 0, "serverInstanceNo".
+func (p IDPath) Args() string {
+	args := make([]string, 0, len(p))
+
+	for _, step := range p {
+		if s, ok := step.(string); ok {
+			args = append(args, strconv.Quote(s))
+			continue
+		}
+		args = append(args, fmt.Sprint(step))
+	}
+
+	return strings.Join(args, ", ")
+}
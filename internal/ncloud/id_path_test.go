@@ -0,0 +1,101 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseIDPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expr          string
+		expected      IDPath
+		expectedArgs  string
+		expectedError bool
+	}{
+		"key": {
+			expr:         "id",
+			expected:     IDPath{"id"},
+			expectedArgs: `"id"`,
+		},
+		"snake-case": {
+			expr:         "product.product_id",
+			expected:     IDPath{"product", "productId"},
+			expectedArgs: `"product", "productId"`,
+		},
+		"camel-case": {
+			expr:         "product.productId",
+			expected:     IDPath{"product", "productId"},
+			expectedArgs: `"product", "productId"`,
+		},
+		"array-index": {
+			expr:         "serverInstanceList[0].serverInstanceNo",
+			expected:     IDPath{"serverInstanceList", 0, "serverInstanceNo"},
+			expectedArgs: `"serverInstanceList", 0, "serverInstanceNo"`,
+		},
+		"nested-indices": {
+			expr:         "matrix[1][2]",
+			expected:     IDPath{"matrix", 1, 2},
+			expectedArgs: `"matrix", 1, 2`,
+		},
+		"empty": {
+			expr:          "",
+			expectedError: true,
+		},
+		"empty-segment": {
+			expr:          "product..id",
+			expectedError: true,
+		},
+		"missing-key": {
+			expr:          "[0].id",
+			expectedError: true,
+		},
+		"unterminated-index": {
+			expr:          "list[0.id",
+			expectedError: true,
+		},
+		"trailing-characters": {
+			expr:          "list[0]x.id",
+			expectedError: true,
+		},
+		"negative-index": {
+			expr:          "list[-1].id",
+			expectedError: true,
+		},
+		"non-numeric-index": {
+			expr:          "list[first].id",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseIDPath(testCase.expr)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Args(), testCase.expectedArgs); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package ncloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+)
+
+// ImportState describes how generated resources split the import ID into the attributes identifying the remote object.
+type ImportState struct {
+	Separator string
+	Parts     []*ImportStatePart
+}
+
+// ImportStatePart is a part of the import ID, set into Attribute once converted into Type.
+type ImportStatePart struct {
+	Attribute string
+
+	// Type is either "string" or "int64".
+	Type string
+}
+
+// NewImportState builds the import ID format of a resource from the import_state_override field of the specification.
+// Nil is returned when the import ID is used as is.
+func NewImportState(o *util.ImportStateOverride) (*ImportState, error) {
+	if o == nil || len(o.Parts) == 0 {
+		return nil, nil
+	}
+
+	s := &ImportState{Separator: o.Separator}
+	if s.Separator == "" {
+		s.Separator = "."
+	}
+
+	seen := make(map[string]bool)
+
+	for idx, p := range o.Parts {
+		if p == nil || p.Name == "" {
+			return nil, fmt.Errorf("import_state_override.parts[%d]: name is required", idx)
+		}
+
+		if seen[p.Name] {
+			return nil, fmt.Errorf("import_state_override.parts[%d]: %q is declared twice", idx, p.Name)
+		}
+		seen[p.Name] = true
+
+		part := &ImportStatePart{Attribute: p.Name, Type: p.Type}
+
+		switch part.Type {
+		case "":
+			part.Type = "string"
+		case "string", "int64":
+		default:
+			return nil, fmt.Errorf("import_state_override.parts[%d]: unsupported type %q, expected string or int64", idx, p.Type)
+		}
+
+		s.Parts = append(s.Parts, part)
+	}
+
+	return s, nil
+}
+
+// Format returns the expected import ID shown in diagnostics, e.g. <product_id>:<api_id>.
+func (s *ImportState) Format() string {
+	parts := make([]string, 0, len(s.Parts))
+
+	for _, p := range s.Parts {
+		parts = append(parts, "<"+p.Attribute+">")
+	}
+
+	return strings.Join(parts, s.Separator)
+}
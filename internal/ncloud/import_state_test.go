@@ -0,0 +1,110 @@
+package ncloud
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewImportState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input          string
+		expected       *ImportState
+		expectedFormat string
+		expectedError  bool
+	}{
+		"not-set": {
+			input: `{}`,
+		},
+		"single-part-string": {
+			input: `{"import_state_override": "product-id"}`,
+		},
+		"dotted-string": {
+			input: `{"import_state_override": "product-id.api-id"}`,
+			expected: &ImportState{
+				Separator: ".",
+				Parts: []*ImportStatePart{
+					{Attribute: "productid", Type: "string"},
+					{Attribute: "apiid", Type: "string"},
+				},
+			},
+			expectedFormat: "<productid>.<apiid>",
+		},
+		"typed-parts": {
+			input: `{"import_state_override": {"separator": ":", "parts": [{"name": "product_id"}, {"name": "stage_no", "type": "int64"}]}}`,
+			expected: &ImportState{
+				Separator: ":",
+				Parts: []*ImportStatePart{
+					{Attribute: "product_id", Type: "string"},
+					{Attribute: "stage_no", Type: "int64"},
+				},
+			},
+			expectedFormat: "<product_id>:<stage_no>",
+		},
+		"default-separator": {
+			input: `{"import_state_override": {"parts": [{"name": "product_id"}, {"name": "api_id", "type": "string"}]}}`,
+			expected: &ImportState{
+				Separator: ".",
+				Parts: []*ImportStatePart{
+					{Attribute: "product_id", Type: "string"},
+					{Attribute: "api_id", Type: "string"},
+				},
+			},
+			expectedFormat: "<product_id>.<api_id>",
+		},
+		"unsupported-type": {
+			input:         `{"import_state_override": {"parts": [{"name": "product_id", "type": "bool"}]}}`,
+			expectedError: true,
+		},
+		"missing-name": {
+			input:         `{"import_state_override": {"parts": [{"type": "int64"}]}}`,
+			expectedError: true,
+		},
+		"duplicated-name": {
+			input:         `{"import_state_override": {"parts": [{"name": "product_id"}, {"name": "product_id"}]}}`,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var r util.Resource
+			if err := json.Unmarshal([]byte(testCase.input), &r); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := NewImportState(r.ImportStateOverride)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if got == nil {
+				return
+			}
+
+			if diff := cmp.Diff(got.Format(), testCase.expectedFormat); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
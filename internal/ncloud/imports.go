@@ -0,0 +1,56 @@
+package ncloud
+
+import (
+	"path"
+	"path/filepath"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+)
+
+// SDKPackageName is the package of the client called by generated resources and data sources,
+// which is written into a directory of the same name within the output directory.
+const SDKPackageName = "ncloudsdk"
+
+// knownImports resolves the packages used by the rendered code which are not imported by the fragments using them,
+// e.g. the CRUD templates. Only the ones a file uses are imported; see format.Assemble.
+// The SDK is imported from the path given to AssembleFile.
+var knownImports = []code.Import{
+	{Path: "context"},
+	{Path: "fmt"},
+	{Path: "regexp"},
+	{Path: "strconv"},
+	{Path: "strings"},
+	{Path: "time"},
+	{Path: "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"},
+	{Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+	{Path: "github.com/hashicorp/terraform-plugin-framework/attr"},
+	{Path: "github.com/hashicorp/terraform-plugin-framework/datasource"},
+	{Path: "github.com/hashicorp/terraform-plugin-framework/diag"},
+	{Path: "github.com/hashicorp/terraform-plugin-framework/path"},
+	{Path: "github.com/hashicorp/terraform-plugin-framework/resource"},
+	{Path: "github.com/hashicorp/terraform-plugin-framework/schema/validator"},
+	{Path: "github.com/hashicorp/terraform-plugin-framework/types"},
+	{Path: "github.com/hashicorp/terraform-plugin-framework/types/basetypes"},
+	{Path: "github.com/hashicorp/terraform-plugin-log/tflog"},
+	{Path: "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"},
+	{Path: "github.com/terraform-providers/terraform-provider-ncloud/internal/common"},
+	{Path: "github.com/terraform-providers/terraform-provider-ncloud/internal/conn"},
+}
+
+// SDKImportPath returns the import path of the SDK written into outputDir.
+// importPath is the import path of outputDir, detected from the go.mod file of the module containing outputDir when empty.
+func SDKImportPath(outputDir, importPath string) (string, error) {
+	if importPath == "" {
+		abs, err := filepath.Abs(outputDir)
+		if err != nil {
+			return "", err
+		}
+
+		importPath, err = moduleImportPath(abs)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return path.Join(importPath, SDKPackageName), nil
+}
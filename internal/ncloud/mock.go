@@ -0,0 +1,163 @@
+package ncloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+)
+
+// Kinds of requests served by the mock API server.
+const (
+	MockRouteCreate = "create"
+	MockRouteRead   = "read"
+	MockRouteUpdate = "update"
+	MockRouteDelete = "delete"
+)
+
+// MockRoute is a request of crud_parameters served by the mock API server.
+type MockRoute struct {
+	// Method is the upper case HTTP method.
+	Method string
+
+	// Pattern is the regular expression matching the request path. Its last group captures the id of the object.
+	Pattern string
+
+	Kind string
+}
+
+// Mock describes the in-memory API server generated for the unit tests of a resource.
+// Objects are stored with the fields of the requests, and responses nest them under Wrapper,
+// the way the create response holds the id.
+type Mock struct {
+	Routes []*MockRoute
+
+	// Wrapper are the keys and array indices the object is nested under in responses.
+	Wrapper IDPath
+
+	// IDKey is the key of the id within the object.
+	IDKey string
+
+	// StatusKey is the key of the status within the object, set when the resource waits for a status.
+	StatusKey string
+
+	// CreateStatus and UpdateStatus are the statuses reported once the operation completed.
+	CreateStatus string
+	UpdateStatus string
+
+	// NotFoundStatus and NotFoundBody are the response to requests for a missing object, following the not found detection of the read operation.
+	NotFoundStatus int
+	NotFoundBody   string
+}
+
+// NewMock builds the mock API server of a resource from its operations, id path and wait configuration.
+func NewMock(ops *CrudOperations, id string, wait *util.WaitConfig) (*Mock, error) {
+	if id == "" {
+		return nil, fmt.Errorf("mock: id is required")
+	}
+
+	p, err := ParseIDPath(id)
+	if err != nil {
+		return nil, fmt.Errorf("mock: %w", err)
+	}
+
+	idKey, ok := p[len(p)-1].(string)
+	if !ok {
+		return nil, fmt.Errorf("mock: id %q must end with a key", id)
+	}
+
+	m := &Mock{IDKey: idKey}
+	if len(p) > 1 {
+		m.Wrapper = p[:len(p)-1]
+	}
+
+	for _, route := range []struct {
+		op   *Operation
+		kind string
+	}{
+		{ops.Create, MockRouteCreate},
+		{ops.Read, MockRouteRead},
+		{ops.Delete, MockRouteDelete},
+	} {
+		if route.op == nil {
+			continue
+		}
+		m.Routes = append(m.Routes, newMockRoute(route.op, route.kind))
+	}
+
+	for _, op := range ops.Update {
+		m.Routes = append(m.Routes, newMockRoute(op, MockRouteUpdate))
+	}
+
+	if wait != nil && wait.StatusPath != "" {
+		statusPath := strings.Split(wait.StatusPath, ".")
+		m.StatusKey = statusPath[len(statusPath)-1]
+
+		if wait.Create != nil && len(wait.Create.Target) > 0 {
+			m.CreateStatus = wait.Create.Target[0]
+		}
+
+		if wait.Update != nil && len(wait.Update.Target) > 0 {
+			m.UpdateStatus = wait.Update.Target[0]
+		}
+	}
+
+	if m.NotFoundStatus, m.NotFoundBody, err = mockNotFound(ops.Read); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// newMockRoute returns the route of the operation, capturing every path parameter.
+func newMockRoute(op *Operation, kind string) *MockRoute {
+	var pattern strings.Builder
+
+	path := "/" + strings.Trim(op.Path, "/")
+	last := 0
+
+	for _, loc := range pathParameterPattern.FindAllStringIndex(path, -1) {
+		pattern.WriteString(regexp.QuoteMeta(path[last:loc[0]]))
+		pattern.WriteString("([^/]+)")
+		last = loc[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(path[last:]))
+
+	return &MockRoute{
+		Method:  strings.ToUpper(op.Method),
+		Pattern: "^" + pattern.String() + "$",
+		Kind:    kind,
+	}
+}
+
+// mockNotFound returns the response which the generated client detects as not found for the read operation.
+func mockNotFound(read *Operation) (int, string, error) {
+	if read == nil || read.NotFound == nil {
+		return http.StatusNotFound, "", nil
+	}
+
+	n := read.NotFound
+
+	body := ""
+	if len(n.ErrorCodes) > 0 {
+		b, err := json.Marshal(map[string]interface{}{
+			"error": map[string]interface{}{"errorCode": n.ErrorCodes[0]},
+		})
+		if err != nil {
+			return 0, "", err
+		}
+		body = string(b)
+	}
+
+	switch {
+	case len(n.StatusCodes) > 0:
+		return n.StatusCodes[0], body, nil
+	case len(n.ErrorCodes) > 0:
+		return http.StatusBadRequest, body, nil
+	default:
+		return http.StatusOK, "", nil
+	}
+}
@@ -0,0 +1,147 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewMock(t *testing.T) {
+	t.Parallel()
+
+	ops := &CrudOperations{
+		Create: &Operation{Method: "post", Path: "/products"},
+		Read:   &Operation{Method: "GET", Path: "/products/{product-id}", NotFound: &NotFound{StatusCodes: []int{404}}},
+		Update: []*Operation{
+			{Method: "PATCH", Path: "/products/{product-id}"},
+			{Method: "PUT", Path: "/products/{product-id}/stages/{stage.id}"},
+		},
+		Delete: &Operation{Method: "DELETE", Path: "/products/{product-id}"},
+	}
+
+	routes := []*MockRoute{
+		{Method: "POST", Pattern: "^/products$", Kind: MockRouteCreate},
+		{Method: "GET", Pattern: "^/products/([^/]+)$", Kind: MockRouteRead},
+		{Method: "DELETE", Pattern: "^/products/([^/]+)$", Kind: MockRouteDelete},
+		{Method: "PATCH", Pattern: "^/products/([^/]+)$", Kind: MockRouteUpdate},
+		{Method: "PUT", Pattern: `^/products/([^/]+)/stages/([^/]+)$`, Kind: MockRouteUpdate},
+	}
+
+	testCases := map[string]struct {
+		ops           *CrudOperations
+		id            string
+		wait          *util.WaitConfig
+		expected      *Mock
+		expectedError bool
+	}{
+		"default": {
+			ops: ops,
+			id:  "product.product_id",
+			expected: &Mock{
+				Routes:         routes,
+				Wrapper:        IDPath{"product"},
+				IDKey:          "productId",
+				NotFoundStatus: 404,
+			},
+		},
+		"wait": {
+			ops: ops,
+			id:  "id",
+			wait: &util.WaitConfig{
+				StatusPath: "product.status",
+				Create:     &util.WaitStates{Pending: []string{"INIT"}, Target: []string{"RUN"}},
+				Update:     &util.WaitStates{Target: []string{"READY", "RUN"}},
+			},
+			expected: &Mock{
+				Routes:         routes,
+				IDKey:          "id",
+				StatusKey:      "status",
+				CreateStatus:   "RUN",
+				UpdateStatus:   "READY",
+				NotFoundStatus: 404,
+			},
+		},
+		"not-found-error-code": {
+			ops: &CrudOperations{
+				Read: &Operation{Method: "GET", Path: "/products", NotFound: &NotFound{ErrorCodes: []string{"3000"}}},
+			},
+			id: "id",
+			expected: &Mock{
+				Routes:         []*MockRoute{{Method: "GET", Pattern: "^/products$", Kind: MockRouteRead}},
+				IDKey:          "id",
+				NotFoundStatus: 400,
+				NotFoundBody:   `{"error":{"errorCode":"3000"}}`,
+			},
+		},
+		"not-found-empty-body": {
+			ops: &CrudOperations{
+				Read: &Operation{Method: "GET", Path: "/products", NotFound: &NotFound{EmptyBody: true}},
+			},
+			id: "id",
+			expected: &Mock{
+				Routes:         []*MockRoute{{Method: "GET", Pattern: "^/products$", Kind: MockRouteRead}},
+				IDKey:          "id",
+				NotFoundStatus: 200,
+			},
+		},
+		"embedded-parameter": {
+			ops: &CrudOperations{
+				Read: &Operation{Method: "GET", Path: "/products/{product-id}:detail"},
+			},
+			id: "id",
+			expected: &Mock{
+				Routes:         []*MockRoute{{Method: "GET", Pattern: "^/products/([^/]+):detail$", Kind: MockRouteRead}},
+				IDKey:          "id",
+				NotFoundStatus: 404,
+			},
+		},
+		"array-wrapped-id": {
+			ops: &CrudOperations{
+				Read: &Operation{Method: "GET", Path: "/servers"},
+			},
+			id: "serverInstanceList[0].serverInstanceNo",
+			expected: &Mock{
+				Routes:         []*MockRoute{{Method: "GET", Pattern: "^/servers$", Kind: MockRouteRead}},
+				Wrapper:        IDPath{"serverInstanceList", 0},
+				IDKey:          "serverInstanceNo",
+				NotFoundStatus: 404,
+			},
+		},
+		"id-ending-with-index": {
+			ops:           ops,
+			id:            "ids[0]",
+			expectedError: true,
+		},
+		"missing-id": {
+			ops:           ops,
+			id:            "",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewMock(testCase.ops, testCase.id, testCase.wait)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
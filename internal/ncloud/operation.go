@@ -0,0 +1,473 @@
+package ncloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+)
+
+// ParameterLocation indicates where a request field is sent to the API.
+type ParameterLocation string
+
+const (
+	ParameterLocationPath  ParameterLocation = "path"
+	ParameterLocationQuery ParameterLocation = "query"
+	ParameterLocationBody  ParameterLocation = "body"
+)
+
+// UnsupportedFieldError is returned when a field of crud_parameters can not be mapped into a request.
+type UnsupportedFieldError struct {
+	Method string
+	Path   string
+	Field  string
+	Reason string
+}
+
+func (e *UnsupportedFieldError) Error() string {
+	return fmt.Sprintf("%s %s: field %q is not supported: %s", strings.ToUpper(e.Method), e.Path, e.Field, e.Reason)
+}
+
+// FieldMapping maps a single field of an API request to the model attribute holding its value.
+type FieldMapping struct {
+	// Name is the field name as written in the API specification.
+	Name string
+
+	// FieldName is the name of the field in the generated request struct.
+	FieldName string
+
+	// AttributeName is the name of the field in the generated model.
+	AttributeName string
+
+	// StateKey is the key used to look up the value from the terraform state in generated tests.
+	StateKey string
+
+	// Example is the example value of the field in the API specification, if any.
+	Example any
+
+	Type     string
+	Format   string
+	Location ParameterLocation
+	Required bool
+}
+
+// ValueAccessor returns the method call which extracts the Go value from the model attribute.
+func (f *FieldMapping) ValueAccessor() string {
+	switch f.Type {
+	case "string":
+		return "ValueString()"
+	case "integer":
+		if f.Format == "int32" {
+			return "ValueInt32()"
+		}
+		return "ValueInt64()"
+	case "number":
+		if f.Format == "float" {
+			return "ValueFloat32()"
+		}
+		return "ValueFloat64()"
+	case "boolean":
+		return "ValueBool()"
+	case "array", "object":
+		// Array and Object are treated as string with serialization outside of the request body.
+		return "String()"
+	default:
+		return "ValueString()"
+	}
+}
+
+// GoType returns the type of the field in the generated request struct.
+// Optional scalar fields are pointers, so zero values such as false or "" can be sent when they are set.
+func (f *FieldMapping) GoType() string {
+	if !f.Required && f.IsScalar() {
+		return "*" + f.valueType()
+	}
+
+	return f.valueType()
+}
+
+func (f *FieldMapping) valueType() string {
+	switch f.Type {
+	case "string":
+		return "string"
+	case "integer":
+		if f.Format == "int32" {
+			return "int32"
+		}
+		return "int64"
+	case "number":
+		if f.Format == "float" {
+			return "float32"
+		}
+		return "float64"
+	case "boolean":
+		return "bool"
+	}
+
+	switch {
+	case f.IsList():
+		return "types.List"
+	case f.IsObject():
+		return "types.Object"
+	default:
+		return "string"
+	}
+}
+
+// ModelType returns the type of the model field which ValueAccessor reads the value from, e.g. types.Int32 for an int32 field.
+// It is empty when any type can be read, as array and object parameters are sent as the string of their value.
+func (f *FieldMapping) ModelType() string {
+	switch {
+	case f.IsList():
+		return "types.List"
+	case f.IsObject():
+		return "types.Object"
+	}
+
+	switch f.ValueAccessor() {
+	case "ValueString()":
+		return "types.String"
+	case "ValueInt32()":
+		return "types.Int32"
+	case "ValueInt64()":
+		return "types.Int64"
+	case "ValueFloat32()":
+		return "types.Float32"
+	case "ValueFloat64()":
+		return "types.Float64"
+	case "ValueBool()":
+		return "types.Bool"
+	default:
+		return ""
+	}
+}
+
+// IsList reports whether the field must be converted into a list before being sent.
+func (f *FieldMapping) IsList() bool {
+	return f.Location == ParameterLocationBody && f.Type == "array"
+}
+
+// IsObject reports whether the field must be converted into an object before being sent.
+func (f *FieldMapping) IsObject() bool {
+	return f.Location == ParameterLocationBody && f.Type == "object"
+}
+
+// IsScalar reports whether the field can be assigned directly within the request struct literal.
+func (f *FieldMapping) IsScalar() bool {
+	return !f.IsList() && !f.IsObject()
+}
+
+// IsNullable reports whether the field can be sent as an explicit null, which only optional body fields can.
+func (f *FieldMapping) IsNullable() bool {
+	return f.Location == ParameterLocationBody && !f.Required
+}
+
+// NotFound describes how an operation reports that the requested object does not exist.
+type NotFound struct {
+	StatusCodes []int
+	ErrorCodes  []string
+	EmptyBody   bool
+}
+
+// Operation is the intermediate model of a single CRUD request, built from util.NcloudCommonRequestType.
+type Operation struct {
+	Method     string
+	Path       string
+	MethodName string
+	Fields     []*FieldMapping
+
+	// NotFound is nil when the operation does not detect missing objects.
+	NotFound *NotFound
+
+	// PartialUpdate is set on PATCH update operations, which only send the optional fields whose value changed.
+	// Optional body fields changed to null are sent as explicit nulls.
+	PartialUpdate bool
+}
+
+// NewOperation builds an Operation from the request information of crud_parameters.
+// A nil request results in an empty Operation, so templates can be rendered unconditionally.
+func NewOperation(r *util.NcloudCommonRequestType) (*Operation, error) {
+	op := &Operation{}
+
+	if r == nil {
+		return op, nil
+	}
+
+	op.Method = r.Method
+	op.Path = r.Path
+	op.MethodName = strings.ToUpper(r.Method) + getMethodName(r.Path)
+	op.NotFound = newNotFound(r)
+
+	if r.Parameters != nil {
+		for _, val := range r.Parameters.Required {
+			f, err := newParameterField(val, r.Path, true)
+			if err != nil {
+				return nil, err
+			}
+			op.Fields = append(op.Fields, f)
+		}
+
+		for _, val := range r.Parameters.Optional {
+			f, err := newParameterField(val, r.Path, false)
+			if err != nil {
+				return nil, err
+			}
+
+			// The path can not be built without the value, so the parameter can not be omitted.
+			if f.Location == ParameterLocationPath {
+				return nil, &UnsupportedFieldError{Method: r.Method, Path: r.Path, Field: f.Name, Reason: "path parameters must be required"}
+			}
+
+			op.Fields = append(op.Fields, f)
+		}
+	}
+
+	if r.RequestBody != nil {
+		for _, val := range r.RequestBody.Required {
+			f, err := newBodyField(val, true)
+			if err != nil {
+				return nil, err
+			}
+			op.Fields = append(op.Fields, f)
+		}
+
+		for _, val := range r.RequestBody.Optional {
+			f, err := newBodyField(val, false)
+			if err != nil {
+				return nil, err
+			}
+			op.Fields = append(op.Fields, f)
+		}
+	}
+
+	for _, f := range op.Fields {
+		if !isSupportedType(f.Type) {
+			return nil, &UnsupportedFieldError{Method: r.Method, Path: r.Path, Field: f.Name, Reason: fmt.Sprintf("unknown type %q", f.Type)}
+		}
+	}
+
+	return op, nil
+}
+
+// newNotFound returns the not found detection of the request. GET requests detect a 404 status code by default.
+func newNotFound(r *util.NcloudCommonRequestType) *NotFound {
+	if r.NotFound != nil {
+		n := &NotFound{
+			StatusCodes: r.NotFound.StatusCodes,
+			ErrorCodes:  r.NotFound.ErrorCodes,
+			EmptyBody:   r.NotFound.EmptyBody,
+		}
+
+		if len(n.StatusCodes) == 0 && len(n.ErrorCodes) == 0 && !n.EmptyBody {
+			n.StatusCodes = []int{http.StatusNotFound}
+		}
+
+		return n
+	}
+
+	if strings.EqualFold(r.Method, http.MethodGet) {
+		return &NotFound{StatusCodes: []int{http.StatusNotFound}}
+	}
+
+	return nil
+}
+
+// isSupportedType reports whether the type can be sent. A missing type is sent as string.
+func isSupportedType(t string) bool {
+	switch t {
+	case "", "string", "integer", "number", "boolean", "array", "object":
+		return true
+	default:
+		return false
+	}
+}
+
+func newParameterField(p *util.RequestParametersInfo, path string, required bool) (*FieldMapping, error) {
+	if p == nil || p.Name == "" {
+		return nil, fmt.Errorf("parameter name is not defined for %s", path)
+	}
+
+	location := ParameterLocationQuery
+	if strings.Contains(path, "{"+p.Name+"}") {
+		location = ParameterLocationPath
+	}
+
+	name := util.PathToPascal(p.Name)
+
+	return &FieldMapping{
+		Name:          p.Name,
+		FieldName:     name,
+		AttributeName: name,
+		StateKey:      stateKey(p.Name),
+		Type:          p.Type,
+		Format:        p.Format,
+		Example:       p.Example,
+		Location:      location,
+		Required:      required,
+	}, nil
+}
+
+func newBodyField(p *util.RequestParametersInfo, required bool) (*FieldMapping, error) {
+	if p == nil || p.Name == "" {
+		return nil, fmt.Errorf("request body field name is not defined")
+	}
+
+	name := util.FirstAlphabetToUpperCase(p.Name)
+
+	return &FieldMapping{
+		Name:          p.Name,
+		FieldName:     name,
+		AttributeName: name,
+		StateKey:      stateKey(p.Name),
+		Type:          p.Type,
+		Format:        p.Format,
+		Example:       p.Example,
+		Location:      ParameterLocationBody,
+		Required:      required,
+	}, nil
+}
+
+// stateKey returns the attribute name of the field in the terraform state, in snake_case like the attributes of responses,
+// e.g. product_id for both productId and product-id.
+func stateKey(name string) string {
+	return util.ToSnakeCase(strings.ReplaceAll(name, "-", "_"))
+}
+
+// RequiredFields returns required fields which are assigned within the request struct literal.
+func (o *Operation) RequiredFields() []*FieldMapping {
+	var fields []*FieldMapping
+
+	for _, f := range o.Fields {
+		if f.Required && f.IsScalar() {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// CollectionFields returns required list and object fields which need a conversion before being assigned.
+func (o *Operation) CollectionFields() []*FieldMapping {
+	var fields []*FieldMapping
+
+	for _, f := range o.Fields {
+		if f.Required && !f.IsScalar() {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// OptionalFields returns fields which are only assigned when the model attribute holds a known value.
+func (o *Operation) OptionalFields() []*FieldMapping {
+	var fields []*FieldMapping
+
+	for _, f := range o.Fields {
+		if !f.Required {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// HasNullableFields reports whether the request can send some of its fields as explicit nulls.
+func (o *Operation) HasNullableFields() bool {
+	for _, f := range o.Fields {
+		if f.IsNullable() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ChangeFields returns fields whose modification requires this operation to be called.
+// Path parameters only identify the resource, so they are not taken into account.
+func (o *Operation) ChangeFields() []*FieldMapping {
+	var fields []*FieldMapping
+
+	for _, f := range o.Fields {
+		if f.Location != ParameterLocationPath {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// PathFields returns fields substituted into the request path.
+func (o *Operation) PathFields() []*FieldMapping {
+	return o.fieldsIn(ParameterLocationPath)
+}
+
+// QueryFields returns fields sent as query parameters.
+func (o *Operation) QueryFields() []*FieldMapping {
+	return o.fieldsIn(ParameterLocationQuery)
+}
+
+// BodyFields returns fields sent within the request body.
+func (o *Operation) BodyFields() []*FieldMapping {
+	return o.fieldsIn(ParameterLocationBody)
+}
+
+func (o *Operation) fieldsIn(location ParameterLocation) []*FieldMapping {
+	var fields []*FieldMapping
+
+	for _, f := range o.Fields {
+		if f.Location == location {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// CrudOperations holds the Operation of every request listed in crud_parameters.
+type CrudOperations struct {
+	Create *Operation
+	Read   *Operation
+	Update []*Operation
+	Delete *Operation
+}
+
+func NewCrudOperations(p util.CrudParameters) (*CrudOperations, error) {
+	var err error
+	ops := &CrudOperations{}
+
+	if ops.Create, err = NewOperation(p.Create); err != nil {
+		return nil, fmt.Errorf("create: %w", err)
+	}
+
+	if ops.Read, err = NewOperation(p.Read); err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+
+	for idx, val := range p.Update {
+		op, err := NewOperation(val)
+		if err != nil {
+			return nil, fmt.Errorf("update[%d]: %w", idx, err)
+		}
+		op.PartialUpdate = strings.EqualFold(op.Method, http.MethodPatch)
+		ops.Update = append(ops.Update, op)
+	}
+
+	if ops.Delete, err = NewOperation(p.Delete); err != nil {
+		return nil, fmt.Errorf("delete: %w", err)
+	}
+
+	return ops, nil
+}
+
+// parseWithRequestTemplate parses the given template together with the shared request templates.
+func parseWithRequestTemplate(funcMap template.FuncMap, text string) (*template.Template, error) {
+	t, err := template.New("").Funcs(funcMap).Parse(RequestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Parse(text)
+}
@@ -0,0 +1,324 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request       *util.NcloudCommonRequestType
+		expected      *Operation
+		expectedError bool
+	}{
+		"nil": {
+			request:  nil,
+			expected: &Operation{},
+		},
+		"path-query-and-body": {
+			request: &util.NcloudCommonRequestType{
+				Method: "PATCH",
+				Path:   "/products/{product-id}",
+				DetailedRequestType: util.DetailedRequestType{
+					Parameters: &util.RequestParameters{
+						Required: []*util.RequestParametersInfo{
+							{Name: "product-id", Type: "string"},
+						},
+						Optional: []*util.RequestParametersInfo{
+							{Name: "force", Type: "boolean"},
+						},
+					},
+					RequestBody: &util.NcloudRequestBody{
+						Required: []*util.RequestParametersInfo{
+							{Name: "productName", Type: "string"},
+							{Name: "tags", Type: "array"},
+						},
+						Optional: []*util.RequestParametersInfo{
+							{Name: "throttleRate", Type: "integer", Format: "int32"},
+						},
+					},
+				},
+			},
+			expected: &Operation{
+				Method:     "PATCH",
+				Path:       "/products/{product-id}",
+				MethodName: "PATCHProductsProductid",
+				Fields: []*FieldMapping{
+					{Name: "product-id", FieldName: "Productid", AttributeName: "Productid", StateKey: "product_id", Type: "string", Location: ParameterLocationPath, Required: true},
+					{Name: "force", FieldName: "Force", AttributeName: "Force", StateKey: "force", Type: "boolean", Location: ParameterLocationQuery},
+					{Name: "productName", FieldName: "ProductName", AttributeName: "ProductName", StateKey: "product_name", Type: "string", Location: ParameterLocationBody, Required: true},
+					{Name: "tags", FieldName: "Tags", AttributeName: "Tags", StateKey: "tags", Type: "array", Location: ParameterLocationBody, Required: true},
+					{Name: "throttleRate", FieldName: "ThrottleRate", AttributeName: "ThrottleRate", StateKey: "throttle_rate", Type: "integer", Format: "int32", Location: ParameterLocationBody},
+				},
+			},
+		},
+		"missing-name": {
+			request: &util.NcloudCommonRequestType{
+				Method: "GET",
+				Path:   "/products",
+				DetailedRequestType: util.DetailedRequestType{
+					Parameters: &util.RequestParameters{
+						Required: []*util.RequestParametersInfo{{Type: "string"}},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		"optional-path-parameter": {
+			request: &util.NcloudCommonRequestType{
+				Method: "DELETE",
+				Path:   "/products/{product-id}",
+				DetailedRequestType: util.DetailedRequestType{
+					Parameters: &util.RequestParameters{
+						Optional: []*util.RequestParametersInfo{{Name: "product-id", Type: "string"}},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		"unknown-type": {
+			request: &util.NcloudCommonRequestType{
+				Method: "DELETE",
+				Path:   "/products",
+				DetailedRequestType: util.DetailedRequestType{
+					RequestBody: &util.NcloudRequestBody{
+						Optional: []*util.RequestParametersInfo{{Name: "file", Type: "binary"}},
+					},
+				},
+			},
+			expectedError: true,
+		},
+		"get-not-found-default": {
+			request: &util.NcloudCommonRequestType{
+				Method: "GET",
+				Path:   "/products",
+			},
+			expected: &Operation{
+				Method:     "GET",
+				Path:       "/products",
+				MethodName: "GETProducts",
+				NotFound:   &NotFound{StatusCodes: []int{404}},
+			},
+		},
+		"embedded-path-parameter": {
+			request: &util.NcloudCommonRequestType{
+				Method: "DELETE",
+				Path:   "/products/{product-id}.json",
+				DetailedRequestType: util.DetailedRequestType{
+					Parameters: &util.RequestParameters{
+						Required: []*util.RequestParametersInfo{
+							{Name: "product-id", Type: "string"},
+						},
+					},
+				},
+			},
+			expected: &Operation{
+				Method:     "DELETE",
+				Path:       "/products/{product-id}.json",
+				MethodName: "DELETEProductsProductidjson",
+				Fields: []*FieldMapping{
+					{Name: "product-id", FieldName: "Productid", AttributeName: "Productid", StateKey: "product_id", Type: "string", Location: ParameterLocationPath, Required: true},
+				},
+			},
+		},
+		"not-found-configured": {
+			request: &util.NcloudCommonRequestType{
+				Method:   "POST",
+				Path:     "/products/search",
+				NotFound: &util.NotFound{ErrorCodes: []string{"3000"}, EmptyBody: true},
+			},
+			expected: &Operation{
+				Method:     "POST",
+				Path:       "/products/search",
+				MethodName: "POSTProductsSearch",
+				NotFound:   &NotFound{ErrorCodes: []string{"3000"}, EmptyBody: true},
+			},
+		},
+		"not-found-empty": {
+			request: &util.NcloudCommonRequestType{
+				Method:   "POST",
+				Path:     "/products/search",
+				NotFound: &util.NotFound{},
+			},
+			expected: &Operation{
+				Method:     "POST",
+				Path:       "/products/search",
+				MethodName: "POSTProductsSearch",
+				NotFound:   &NotFound{StatusCodes: []int{404}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewOperation(testCase.request)
+
+			if (err != nil) != testCase.expectedError {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFieldMapping_ValueAccessor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		field    FieldMapping
+		expected string
+	}{
+		"string":         {field: FieldMapping{Type: "string"}, expected: "ValueString()"},
+		"integer":        {field: FieldMapping{Type: "integer"}, expected: "ValueInt64()"},
+		"integer-int32":  {field: FieldMapping{Type: "integer", Format: "int32"}, expected: "ValueInt32()"},
+		"number":         {field: FieldMapping{Type: "number"}, expected: "ValueFloat64()"},
+		"number-float":   {field: FieldMapping{Type: "number", Format: "float"}, expected: "ValueFloat32()"},
+		"boolean":        {field: FieldMapping{Type: "boolean"}, expected: "ValueBool()"},
+		"array-in-query": {field: FieldMapping{Type: "array", Location: ParameterLocationQuery}, expected: "String()"},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.field.ValueAccessor(); got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFieldMapping_ModelType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		field    FieldMapping
+		expected string
+	}{
+		"untyped":        {field: FieldMapping{}, expected: "types.String"},
+		"integer-int32":  {field: FieldMapping{Type: "integer", Format: "int32"}, expected: "types.Int32"},
+		"number":         {field: FieldMapping{Type: "number"}, expected: "types.Float64"},
+		"array-in-body":  {field: FieldMapping{Type: "array", Location: ParameterLocationBody}, expected: "types.List"},
+		"array-in-query": {field: FieldMapping{Type: "array", Location: ParameterLocationQuery}, expected: ""},
+		"object-in-body": {field: FieldMapping{Type: "object", Location: ParameterLocationBody}, expected: "types.Object"},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.field.ModelType(); got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFieldMapping_GoType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		field    FieldMapping
+		expected string
+	}{
+		"required-string":        {field: FieldMapping{Type: "string", Location: ParameterLocationBody, Required: true}, expected: "string"},
+		"optional-boolean":       {field: FieldMapping{Type: "boolean", Location: ParameterLocationBody}, expected: "*bool"},
+		"optional-integer-int32": {field: FieldMapping{Type: "integer", Format: "int32", Location: ParameterLocationQuery}, expected: "*int32"},
+		"optional-array-in-body": {field: FieldMapping{Type: "array", Location: ParameterLocationBody}, expected: "types.List"},
+		"required-object":        {field: FieldMapping{Type: "object", Location: ParameterLocationBody, Required: true}, expected: "types.Object"},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.field.GoType(); got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestFieldMapping_IsNullable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		field    FieldMapping
+		expected bool
+	}{
+		"optional-body":  {field: FieldMapping{Type: "string", Location: ParameterLocationBody}, expected: true},
+		"required-body":  {field: FieldMapping{Type: "string", Location: ParameterLocationBody, Required: true}, expected: false},
+		"optional-query": {field: FieldMapping{Type: "string", Location: ParameterLocationQuery}, expected: false},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.field.IsNullable(); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewCrudOperations_PartialUpdate(t *testing.T) {
+	t.Parallel()
+
+	ops, err := NewCrudOperations(util.CrudParameters{
+		Create: &util.NcloudCommonRequestType{Method: "POST", Path: "/products"},
+		Update: []*util.NcloudCommonRequestType{
+			{Method: "patch", Path: "/products/{product-id}"},
+			{Method: "PUT", Path: "/products/{product-id}/tags"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := []bool{ops.Create.PartialUpdate, ops.Update[0].PartialUpdate, ops.Update[1].PartialUpdate}
+
+	if diff := cmp.Diff(got, []bool{false, true, false}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestOperation_ChangeFields(t *testing.T) {
+	t.Parallel()
+
+	op := &Operation{
+		Fields: []*FieldMapping{
+			{Name: "product-id", Location: ParameterLocationPath, Required: true},
+			{Name: "force", Location: ParameterLocationQuery},
+			{Name: "productName", Location: ParameterLocationBody, Required: true},
+		},
+	}
+
+	expected := []*FieldMapping{
+		{Name: "force", Location: ParameterLocationQuery},
+		{Name: "productName", Location: ParameterLocationBody, Required: true},
+	}
+
+	if diff := cmp.Diff(op.ChangeFields(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
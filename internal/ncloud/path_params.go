@@ -0,0 +1,191 @@
+package ncloud
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+)
+
+// IDAttribute is the attribute holding the resource identifier.
+const IDAttribute = "id"
+
+// pathParameterPattern matches the {param} of a path, which may be a whole segment or a part of it, e.g. {file-name}.json.
+var pathParameterPattern = regexp.MustCompile(`\{([^{}/]+)\}`)
+
+// PathParameters resolves the {param} segments of request paths into the attributes holding their values.
+// A parameter is resolved through the explicit mapping first. Otherwise the resource identifier is resolved into id,
+// and other parameters into the attribute of the same name, ignoring case, hyphens and underscores.
+type PathParameters struct {
+	mapping    map[string]string
+	identifier string
+	attributes map[string]string
+	names      map[string]bool
+	fields     map[string]string
+}
+
+// NewPathParameters returns the resolution of path parameters into the given attributes.
+// The identifier is the parameter holding the resource identifier, empty if there is none.
+// Attributes are given with the type of their model field, e.g. types.String, which is empty when the model has no field for them.
+func NewPathParameters(mapping map[string]string, identifier string, attributes map[string]string) *PathParameters {
+	p := &PathParameters{
+		mapping:    mapping,
+		identifier: identifier,
+		attributes: make(map[string]string),
+		names:      make(map[string]bool),
+		fields:     map[string]string{"ID": "types.String"},
+	}
+
+	for name, valueType := range attributes {
+		p.attributes[normalizeParameterName(name)] = name
+		p.names[name] = true
+		p.fields[util.ToPascalCase(name)] = valueType
+	}
+
+	return p
+}
+
+// Resolve sets the attribute of every path field of op.
+// It fails when a parameter of the path is not declared in the operation, or can not be resolved into an attribute,
+// and when a field is read from an attribute which is not part of the schema, or whose type does not match the field.
+func (p *PathParameters) Resolve(op *Operation) error {
+	fields := make(map[string]*FieldMapping)
+	for _, f := range op.PathFields() {
+		fields[f.Name] = f
+	}
+
+	for _, name := range pathParameterNames(op.Path) {
+		f, ok := fields[name]
+		if !ok {
+			return &UnsupportedFieldError{Method: op.Method, Path: op.Path, Field: name, Reason: "path parameter is not declared in parameters"}
+		}
+
+		attribute, err := p.attribute(name)
+		if err != nil {
+			return &UnsupportedFieldError{Method: op.Method, Path: op.Path, Field: name, Reason: err.Error()}
+		}
+
+		// The identifier is a string attribute, which the request can only send as is.
+		if attribute == IDAttribute && f.Type != "" && f.Type != "string" {
+			return &UnsupportedFieldError{Method: op.Method, Path: op.Path, Field: name, Reason: fmt.Sprintf("it is resolved into id, which is a string, but has the type %q", f.Type)}
+		}
+
+		f.StateKey = attribute
+		f.AttributeName = util.ToPascalCase(attribute)
+		if attribute == IDAttribute {
+			f.AttributeName = "ID"
+		}
+	}
+
+	for _, f := range op.Fields {
+		valueType, ok := p.fields[f.AttributeName]
+		if !ok {
+			return &UnsupportedFieldError{Method: op.Method, Path: op.Path, Field: f.Name, Reason: fmt.Sprintf("the schema has no attribute for the model field %s", f.AttributeName)}
+		}
+
+		// The value is read with the accessor of the field type, e.g. ValueInt32() for an int32 field, which the model field must provide.
+		if expected := f.ModelType(); expected != "" && expected != valueType {
+			return &UnsupportedFieldError{Method: op.Method, Path: op.Path, Field: f.Name, Reason: fmt.Sprintf("the field is read from a %s model field, but %s is %q", expected, f.AttributeName, valueType)}
+		}
+	}
+
+	return nil
+}
+
+func (p *PathParameters) attribute(name string) (string, error) {
+	if attribute, ok := p.mapping[name]; ok {
+		if attribute != IDAttribute && !p.names[attribute] {
+			return "", fmt.Errorf("path_parameters maps it into %q, which is not an attribute of the schema", attribute)
+		}
+		return attribute, nil
+	}
+
+	if name == p.identifier {
+		return IDAttribute, nil
+	}
+
+	if attribute, ok := p.attributes[normalizeParameterName(name)]; ok {
+		return attribute, nil
+	}
+
+	return "", fmt.Errorf("no attribute of the same name, map it with path_parameters")
+}
+
+// ResolvePathParameters resolves the path parameters of every operation.
+func (ops *CrudOperations) ResolvePathParameters(p *PathParameters) error {
+	for _, op := range []struct {
+		op   *Operation
+		name string
+	}{
+		{ops.Create, "create"},
+		{ops.Read, "read"},
+		{ops.Delete, "delete"},
+	} {
+		if op.op == nil {
+			continue
+		}
+
+		if err := p.Resolve(op.op); err != nil {
+			return fmt.Errorf("%s: %w", op.name, err)
+		}
+	}
+
+	for idx, op := range ops.Update {
+		if err := p.Resolve(op); err != nil {
+			return fmt.Errorf("update[%d]: %w", idx, err)
+		}
+	}
+
+	return nil
+}
+
+// NewResourceOperations builds the operations of the resource, with their path parameters resolved into its attributes.
+func NewResourceOperations(r *util.Resource) (*CrudOperations, error) {
+	operations, err := NewCrudOperations(r.CRUDParameters)
+	if err != nil {
+		return nil, fmt.Errorf("error occurred with NewCrudOperations: %w", err)
+	}
+
+	attributes := make(map[string]string)
+	if r.Schema != nil {
+		for _, attribute := range r.Schema.Attributes {
+			attributes[attribute.Name], _, _ = resourceModelAttrType(attribute)
+		}
+	}
+
+	pathParameters := NewPathParameters(r.PathParameters, resourceIdentifier(operations.Read.Path), attributes)
+	if err := operations.ResolvePathParameters(pathParameters); err != nil {
+		return nil, err
+	}
+
+	return operations, nil
+}
+
+// resourceIdentifier returns the parameter holding the resource identifier, which is the last parameter of the last segment of the read path,
+// e.g. product-id of /products/{product-id}.
+func resourceIdentifier(readPath string) string {
+	segments := strings.Split(strings.TrimRight(readPath, "/"), "/")
+
+	names := pathParameterNames(segments[len(segments)-1])
+	if len(names) == 0 {
+		return ""
+	}
+
+	return names[len(names)-1]
+}
+
+// pathParameterNames returns the names of the {param} of path, in order.
+func pathParameterNames(path string) []string {
+	var names []string
+
+	for _, match := range pathParameterPattern.FindAllStringSubmatch(path, -1) {
+		names = append(names, match[1])
+	}
+
+	return names
+}
+
+func normalizeParameterName(name string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(name))
+}
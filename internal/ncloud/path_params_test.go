@@ -0,0 +1,229 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPathParameters_Resolve(t *testing.T) {
+	t.Parallel()
+
+	attributes := map[string]string{
+		"product_id":    "types.String",
+		"api_id":        "types.String",
+		"stage_name":    "types.String",
+		"tags":          "types.List",
+		"throttle_rate": "types.Int64",
+	}
+
+	testCases := map[string]struct {
+		mapping       map[string]string
+		identifier    string
+		op            *Operation
+		expected      []*FieldMapping
+		expectedError bool
+	}{
+		"identifier": {
+			identifier: "resource-id",
+			op: &Operation{
+				Method: "GET",
+				Path:   "/products/{product-id}/apis/{apiId}/resources/{resource-id}",
+				Fields: []*FieldMapping{
+					{Name: "product-id", FieldName: "Productid", Location: ParameterLocationPath, Required: true},
+					{Name: "apiId", FieldName: "ApiId", Location: ParameterLocationPath, Required: true},
+					{Name: "resource-id", FieldName: "Resourceid", Location: ParameterLocationPath, Required: true},
+				},
+			},
+			expected: []*FieldMapping{
+				{Name: "product-id", FieldName: "Productid", AttributeName: "ProductId", StateKey: "product_id", Location: ParameterLocationPath, Required: true},
+				{Name: "apiId", FieldName: "ApiId", AttributeName: "ApiId", StateKey: "api_id", Location: ParameterLocationPath, Required: true},
+				{Name: "resource-id", FieldName: "Resourceid", AttributeName: "ID", StateKey: "id", Location: ParameterLocationPath, Required: true},
+			},
+		},
+		"identifier-in-the-middle": {
+			identifier: "product-id",
+			op: &Operation{
+				Method: "PUT",
+				Path:   "/products/{product-id}/tags",
+				Fields: []*FieldMapping{
+					{Name: "product-id", FieldName: "Productid", Location: ParameterLocationPath, Required: true},
+					{Name: "tags", FieldName: "Tags", AttributeName: "Tags", Type: "array", Location: ParameterLocationBody},
+				},
+			},
+			expected: []*FieldMapping{
+				{Name: "product-id", FieldName: "Productid", AttributeName: "ID", StateKey: "id", Location: ParameterLocationPath, Required: true},
+				{Name: "tags", FieldName: "Tags", AttributeName: "Tags", Type: "array", Location: ParameterLocationBody},
+			},
+		},
+		"explicit-mapping": {
+			mapping:    map[string]string{"stage": "stage_name", "product-id": "id"},
+			identifier: "stage",
+			op: &Operation{
+				Method: "DELETE",
+				Path:   "/products/{product-id}/stages/{stage}",
+				Fields: []*FieldMapping{
+					{Name: "product-id", FieldName: "Productid", Location: ParameterLocationPath, Required: true},
+					{Name: "stage", FieldName: "Stage", Location: ParameterLocationPath, Required: true},
+				},
+			},
+			expected: []*FieldMapping{
+				{Name: "product-id", FieldName: "Productid", AttributeName: "ID", StateKey: "id", Location: ParameterLocationPath, Required: true},
+				{Name: "stage", FieldName: "Stage", AttributeName: "StageName", StateKey: "stage_name", Location: ParameterLocationPath, Required: true},
+			},
+		},
+		"embedded-parameter": {
+			identifier: "stage-name",
+			op: &Operation{
+				Method: "GET",
+				Path:   "/products/{product-id}/stages/{stage-name}.json",
+				Fields: []*FieldMapping{
+					{Name: "product-id", FieldName: "Productid", Location: ParameterLocationPath, Required: true},
+					{Name: "stage-name", FieldName: "Stagename", Location: ParameterLocationPath, Required: true},
+				},
+			},
+			expected: []*FieldMapping{
+				{Name: "product-id", FieldName: "Productid", AttributeName: "ProductId", StateKey: "product_id", Location: ParameterLocationPath, Required: true},
+				{Name: "stage-name", FieldName: "Stagename", AttributeName: "ID", StateKey: "id", Location: ParameterLocationPath, Required: true},
+			},
+		},
+		"without-path-parameters": {
+			op: &Operation{
+				Method: "POST",
+				Path:   "/products",
+			},
+		},
+		"unmapped": {
+			op: &Operation{
+				Method: "GET",
+				Path:   "/products/{product-id}/deployments/{deployment-no}",
+				Fields: []*FieldMapping{
+					{Name: "product-id", FieldName: "Productid", Location: ParameterLocationPath, Required: true},
+					{Name: "deployment-no", FieldName: "Deploymentno", Location: ParameterLocationPath, Required: true},
+				},
+			},
+			expectedError: true,
+		},
+		"mapped-into-unknown-attribute": {
+			mapping: map[string]string{"product-id": "product_no"},
+			op: &Operation{
+				Method: "GET",
+				Path:   "/products/{product-id}",
+				Fields: []*FieldMapping{
+					{Name: "product-id", FieldName: "Productid", Location: ParameterLocationPath, Required: true},
+				},
+			},
+			expectedError: true,
+		},
+		"non-string-identifier": {
+			identifier: "product-id",
+			op: &Operation{
+				Method: "GET",
+				Path:   "/products/{product-id}",
+				Fields: []*FieldMapping{
+					{Name: "product-id", FieldName: "Productid", Type: "integer", Location: ParameterLocationPath, Required: true},
+				},
+			},
+			expectedError: true,
+		},
+		"body-field-without-attribute": {
+			identifier: "product-id",
+			op: &Operation{
+				Method: "DELETE",
+				Path:   "/products/{product-id}",
+				Fields: []*FieldMapping{
+					{Name: "product-id", FieldName: "Productid", Location: ParameterLocationPath, Required: true},
+					{Name: "force", FieldName: "Force", AttributeName: "Force", Location: ParameterLocationQuery},
+				},
+			},
+			expectedError: true,
+		},
+		"field-type-mismatch": {
+			identifier: "product-id",
+			op: &Operation{
+				Method: "PATCH",
+				Path:   "/products/{product-id}",
+				Fields: []*FieldMapping{
+					{Name: "product-id", FieldName: "Productid", Location: ParameterLocationPath, Required: true},
+					{Name: "throttleRate", FieldName: "ThrottleRate", AttributeName: "ThrottleRate", Type: "integer", Format: "int32", Location: ParameterLocationBody},
+				},
+			},
+			expectedError: true,
+		},
+		"undeclared": {
+			identifier: "product-id",
+			op: &Operation{
+				Method: "GET",
+				Path:   "/products/{product-id}",
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewPathParameters(testCase.mapping, testCase.identifier, attributes).Resolve(testCase.op)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(testCase.op.Fields, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceIdentifier(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     string
+		expected string
+	}{
+		"trailing-parameter": {
+			path:     "/products/{product-id}",
+			expected: "product-id",
+		},
+		"trailing-slash": {
+			path:     "/products/{product-id}/",
+			expected: "product-id",
+		},
+		"embedded-parameter": {
+			path:     "/products/{product-id}.json",
+			expected: "product-id",
+		},
+		"without-trailing-parameter": {
+			path:     "/products/{product-id}/detail",
+			expected: "",
+		},
+		"empty": {
+			path:     "",
+			expected: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(resourceIdentifier(testCase.path), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -60,5 +60,14 @@ func NewSchema(p *util.NcloudProvider) (generatorschema.GeneratorSchema, error)
 
 	s.DeprecationMessage = p.Schema.DeprecationMessage
 
+	if p.TypeMapping != nil {
+		typeMapping, err := generatorschema.NewTypeMapping(p.TypeMapping.Defaults, p.TypeMapping.Attributes)
+		if err != nil {
+			return s, err
+		}
+
+		s.TypeMapping = typeMapping
+	}
+
 	return s, nil
 }
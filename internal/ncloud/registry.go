@@ -0,0 +1,207 @@
+package ncloud
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+)
+
+// ErrUnknownImportPath is returned when the import path of the output directory is neither given nor found in a go.mod file.
+var ErrUnknownImportPath = errors.New("import path of the output directory is unknown")
+
+// RegistryImport is a package of generated resources or data sources imported by the registry.
+type RegistryImport struct {
+	Alias string
+	Path  string
+}
+
+// Registry lists the constructors of every generated resource and data source of a service,
+// so the provider can return them without being edited whenever an API is added.
+type Registry struct {
+	PackageName  string
+	ProviderName string
+	Imports      []*RegistryImport
+
+	// Resources and DataSources are the constructors, qualified by their package when it is not the package of the registry.
+	Resources   []string
+	DataSources []string
+
+	funcMap template.FuncMap
+}
+
+// NewRegistry builds the registry written into outputDir.
+// When every resource and data source has its own package, they are imported from the subdirectories of importPath, the import path of outputDir.
+// An empty importPath is detected from the go.mod file of the module containing outputDir.
+func NewRegistry(spec util.NcloudSpecification, outputDir, packageName, importPath string) (*Registry, error) {
+	if spec.Provider == nil || spec.Provider.Name == "" {
+		return nil, fmt.Errorf("provider: name is required")
+	}
+
+	r := &Registry{
+		PackageName:  packageName,
+		ProviderName: spec.Provider.Name,
+		funcMap:      util.CreateFuncMap(),
+	}
+
+	var resources, dataSources []string
+	for _, v := range spec.Resources {
+		resources = append(resources, v.Name)
+	}
+	for _, v := range spec.DataSources {
+		dataSources = append(dataSources, v.Name)
+	}
+
+	sort.Strings(resources)
+	sort.Strings(dataSources)
+
+	if packageName != "" {
+		for _, name := range resources {
+			r.Resources = append(r.Resources, fmt.Sprintf("New%sResource", util.ToPascalCase(name)))
+		}
+		for _, name := range dataSources {
+			r.DataSources = append(r.DataSources, fmt.Sprintf("New%sDataSource", util.ToPascalCase(name)))
+		}
+
+		return r, nil
+	}
+
+	abs, err := filepath.Abs(outputDir)
+	if err != nil {
+		return nil, err
+	}
+	r.PackageName = registryPackageName(filepath.Base(abs))
+
+	if len(resources) == 0 && len(dataSources) == 0 {
+		return r, nil
+	}
+
+	if importPath == "" {
+		importPath, err = moduleImportPath(abs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range resources {
+		alias := resourcePackageName(name, "")
+		r.Imports = append(r.Imports, &RegistryImport{Alias: alias, Path: path.Join(importPath, name)})
+		r.Resources = append(r.Resources, fmt.Sprintf("%s.New%sResource", alias, util.ToPascalCase(name)))
+	}
+
+	for _, name := range dataSources {
+		alias := dataSourcePackageName(name, "")
+		r.Imports = append(r.Imports, &RegistryImport{Alias: alias, Path: path.Join(importPath, name+"_data_source")})
+		r.DataSources = append(r.DataSources, fmt.Sprintf("%s.New%sDataSource", alias, util.ToPascalCase(name)))
+	}
+
+	return r, nil
+}
+
+// FileName returns the name of the registry file, which is unique to the service so registries of several services can share a package.
+func (r *Registry) FileName() string {
+	return fmt.Sprintf("%s_registry.go", util.ToLowerCase(r.ProviderName))
+}
+
+func (r *Registry) Render() ([]byte, error) {
+	var b bytes.Buffer
+
+	registryTemplate, err := template.New("").Funcs(r.funcMap).Parse(RegistryTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering registry: %w", err)
+	}
+
+	err = registryTemplate.ExecuteTemplate(&b, "Registry", r)
+	if err != nil {
+		return nil, fmt.Errorf("error occurred with generating Registry template: %w", err)
+	}
+
+	return b.Bytes(), nil
+}
+
+// resourcePackageName returns the package of a generated resource. Without packageName, every resource has its own package.
+func resourcePackageName(name, packageName string) string {
+	if packageName != "" {
+		return packageName
+	}
+
+	return "resource_" + name
+}
+
+// dataSourcePackageName returns the package of a generated data source. Without packageName, every data source has its own package.
+func dataSourcePackageName(name, packageName string) string {
+	if packageName != "" {
+		return packageName
+	}
+
+	return "datasource_" + name
+}
+
+// registryPackageName returns a valid package name from the name of the output directory, e.g. "provider" for internal/provider.
+func registryPackageName(dir string) string {
+	name := strings.ToLower(strings.NewReplacer("-", "_", ".", "_").Replace(dir))
+
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "provider_" + name
+	}
+
+	return name
+}
+
+// moduleImportPath returns the import path of dir, following the module path declared in the closest go.mod file.
+func moduleImportPath(dir string) (string, error) {
+	for moduleDir := dir; ; moduleDir = filepath.Dir(moduleDir) {
+		module, err := readModulePath(filepath.Join(moduleDir, "go.mod"))
+		if err != nil {
+			return "", err
+		}
+
+		if module != "" {
+			rel, err := filepath.Rel(moduleDir, dir)
+			if err != nil {
+				return "", err
+			}
+
+			return path.Join(module, filepath.ToSlash(rel)), nil
+		}
+
+		if filepath.Dir(moduleDir) == moduleDir {
+			return "", fmt.Errorf("%w: no go.mod found for %s", ErrUnknownImportPath, dir)
+		}
+	}
+}
+
+// readModulePath returns the module path declared in the go.mod file, or an empty string when the file does not exist.
+func readModulePath(goMod string) (string, error) {
+	f, err := os.Open(goMod)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if module, ok := strings.CutPrefix(line, "module"); ok && module != "" && (module[0] == ' ' || module[0] == '\t') {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("%s: module path is not declared", goMod)
+}
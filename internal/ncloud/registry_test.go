@@ -0,0 +1,129 @@
+package ncloud
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/provider"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewRegistry(t *testing.T) {
+	t.Parallel()
+
+	spec := util.NcloudSpecification{
+		Provider: &util.NcloudProvider{Provider: provider.Provider{Name: "apigw"}},
+		Resources: []util.Resource{
+			{Resource: resource.Resource{Name: "product"}},
+			{Resource: resource.Resource{Name: "api_key"}},
+		},
+		DataSources: []util.DataSource{
+			{DataSource: datasource.DataSource{Name: "product"}},
+		},
+	}
+
+	testCases := map[string]struct {
+		goMod         string
+		outputDir     string
+		packageName   string
+		importPath    string
+		expected      Registry
+		expectedError bool
+
+		// unknownImportPath is set when the error is expected to wrap ErrUnknownImportPath.
+		unknownImportPath bool
+	}{
+		"single-package": {
+			outputDir:   "internal/provider",
+			packageName: "apigw",
+			expected: Registry{
+				PackageName:  "apigw",
+				ProviderName: "apigw",
+				Resources:    []string{"NewApiKeyResource", "NewProductResource"},
+				DataSources:  []string{"NewProductDataSource"},
+			},
+		},
+		"import-path": {
+			outputDir:  "internal/provider",
+			importPath: "example.com/terraform-provider-ncloud/internal/provider",
+			expected: Registry{
+				PackageName:  "provider",
+				ProviderName: "apigw",
+				Imports: []*RegistryImport{
+					{Alias: "resource_api_key", Path: "example.com/terraform-provider-ncloud/internal/provider/api_key"},
+					{Alias: "resource_product", Path: "example.com/terraform-provider-ncloud/internal/provider/product"},
+					{Alias: "datasource_product", Path: "example.com/terraform-provider-ncloud/internal/provider/product_data_source"},
+				},
+				Resources:   []string{"resource_api_key.NewApiKeyResource", "resource_product.NewProductResource"},
+				DataSources: []string{"datasource_product.NewProductDataSource"},
+			},
+		},
+		"go-mod": {
+			goMod:     "module github.com/terraform-providers/terraform-provider-ncloud\n\ngo 1.22\n",
+			outputDir: "internal/service-apigw",
+			expected: Registry{
+				PackageName:  "service_apigw",
+				ProviderName: "apigw",
+				Imports: []*RegistryImport{
+					{Alias: "resource_api_key", Path: "github.com/terraform-providers/terraform-provider-ncloud/internal/service-apigw/api_key"},
+					{Alias: "resource_product", Path: "github.com/terraform-providers/terraform-provider-ncloud/internal/service-apigw/product"},
+					{Alias: "datasource_product", Path: "github.com/terraform-providers/terraform-provider-ncloud/internal/service-apigw/product_data_source"},
+				},
+				Resources:   []string{"resource_api_key.NewApiKeyResource", "resource_product.NewProductResource"},
+				DataSources: []string{"datasource_product.NewProductDataSource"},
+			},
+		},
+		"go-mod-without-module": {
+			goMod:         "go 1.22\n",
+			outputDir:     "internal/provider",
+			expectedError: true,
+		},
+		"unknown-import-path": {
+			outputDir:         "internal/provider",
+			expectedError:     true,
+			unknownImportPath: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			if testCase.goMod != "" {
+				if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(testCase.goMod), 0644); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			got, err := NewRegistry(spec, filepath.Join(dir, testCase.outputDir), testCase.packageName, testCase.importPath)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				if testCase.unknownImportPath && !errors.Is(err, ErrUnknownImportPath) {
+					t.Errorf("expected ErrUnknownImportPath, got: %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got.funcMap = nil
+
+			if diff := cmp.Diff(*got, testCase.expected, cmp.AllowUnexported(Registry{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package ncloud
+
+import (
+	"fmt"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+)
+
+// CreateOnlyAttributes returns the normalized names of the attributes sent by the create operation which no update operation sends,
+// so they can only be changed by replacing the resource. Path parameters of the create operation, such as the identifier of a parent,
+// are included unless an update operation sends them as a field. The resource identifier is never included.
+// Fields are matched with top-level attributes only, as nested attributes are sent within the value of their parent.
+func (ops *CrudOperations) CreateOnlyAttributes() map[string]bool {
+	updated := make(map[string]bool)
+
+	for _, op := range ops.Update {
+		for _, f := range op.ChangeFields() {
+			updated[normalizeParameterName(f.StateKey)] = true
+		}
+	}
+
+	createOnly := make(map[string]bool)
+
+	if ops.Create == nil {
+		return createOnly
+	}
+
+	for _, f := range ops.Create.Fields {
+		name := normalizeParameterName(f.StateKey)
+
+		if f.StateKey == IDAttribute || updated[name] {
+			continue
+		}
+
+		createOnly[name] = true
+	}
+
+	return createOnly
+}
+
+// AddRequiresReplace adds a RequiresReplaceIfConfigured plan modifier to the create-only attributes of every resource,
+// so changing them plans a replacement instead of an update which can not send them.
+// Computed attributes are skipped, as they can not be configured.
+func AddRequiresReplace(spec *util.NcloudSpecification) error {
+	for _, r := range spec.Resources {
+		if r.Schema == nil {
+			continue
+		}
+
+		operations, err := NewResourceOperations(&r)
+		if err != nil {
+			return fmt.Errorf("resource %s: %w", r.Name, err)
+		}
+
+		createOnly := operations.CreateOnlyAttributes()
+
+		for _, a := range r.Schema.Attributes {
+			if createOnly[normalizeParameterName(a.Name)] {
+				addRequiresReplace(a)
+			}
+		}
+	}
+
+	return nil
+}
+
+// addRequiresReplace appends the plan modifier to the attribute, unless it is computed or already declares it.
+// The attribute types hold pointers, so the attribute is modified in place.
+func addRequiresReplace(a resource.Attribute) {
+	switch {
+	case a.Bool != nil:
+		if m := requiresReplace(a.Bool.ComputedOptionalRequired, "boolplanmodifier", a.Bool.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.Bool.PlanModifiers = append(a.Bool.PlanModifiers, specschema.BoolPlanModifier{Custom: m})
+		}
+	case a.Float32 != nil:
+		if m := requiresReplace(a.Float32.ComputedOptionalRequired, "float32planmodifier", a.Float32.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.Float32.PlanModifiers = append(a.Float32.PlanModifiers, specschema.Float64PlanModifier{Custom: m})
+		}
+	case a.Float64 != nil:
+		if m := requiresReplace(a.Float64.ComputedOptionalRequired, "float64planmodifier", a.Float64.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.Float64.PlanModifiers = append(a.Float64.PlanModifiers, specschema.Float64PlanModifier{Custom: m})
+		}
+	case a.Int64 != nil:
+		if m := requiresReplace(a.Int64.ComputedOptionalRequired, "int64planmodifier", a.Int64.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.Int64.PlanModifiers = append(a.Int64.PlanModifiers, specschema.Int64PlanModifier{Custom: m})
+		}
+	case a.Int32 != nil:
+		if m := requiresReplace(a.Int32.ComputedOptionalRequired, "int32planmodifier", a.Int32.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.Int32.PlanModifiers = append(a.Int32.PlanModifiers, specschema.Int64PlanModifier{Custom: m})
+		}
+	case a.List != nil:
+		if m := requiresReplace(a.List.ComputedOptionalRequired, "listplanmodifier", a.List.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.List.PlanModifiers = append(a.List.PlanModifiers, specschema.ListPlanModifier{Custom: m})
+		}
+	case a.ListNested != nil:
+		if m := requiresReplace(a.ListNested.ComputedOptionalRequired, "listplanmodifier", a.ListNested.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.ListNested.PlanModifiers = append(a.ListNested.PlanModifiers, specschema.ListPlanModifier{Custom: m})
+		}
+	case a.Map != nil:
+		if m := requiresReplace(a.Map.ComputedOptionalRequired, "mapplanmodifier", a.Map.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.Map.PlanModifiers = append(a.Map.PlanModifiers, specschema.MapPlanModifier{Custom: m})
+		}
+	case a.MapNested != nil:
+		if m := requiresReplace(a.MapNested.ComputedOptionalRequired, "mapplanmodifier", a.MapNested.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.MapNested.PlanModifiers = append(a.MapNested.PlanModifiers, specschema.MapPlanModifier{Custom: m})
+		}
+	case a.Number != nil:
+		if m := requiresReplace(a.Number.ComputedOptionalRequired, "numberplanmodifier", a.Number.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.Number.PlanModifiers = append(a.Number.PlanModifiers, specschema.NumberPlanModifier{Custom: m})
+		}
+	case a.Object != nil:
+		if m := requiresReplace(a.Object.ComputedOptionalRequired, "objectplanmodifier", a.Object.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.Object.PlanModifiers = append(a.Object.PlanModifiers, specschema.ObjectPlanModifier{Custom: m})
+		}
+	case a.Set != nil:
+		if m := requiresReplace(a.Set.ComputedOptionalRequired, "setplanmodifier", a.Set.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.Set.PlanModifiers = append(a.Set.PlanModifiers, specschema.SetPlanModifier{Custom: m})
+		}
+	case a.SetNested != nil:
+		if m := requiresReplace(a.SetNested.ComputedOptionalRequired, "setplanmodifier", a.SetNested.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.SetNested.PlanModifiers = append(a.SetNested.PlanModifiers, specschema.SetPlanModifier{Custom: m})
+		}
+	case a.SingleNested != nil:
+		if m := requiresReplace(a.SingleNested.ComputedOptionalRequired, "objectplanmodifier", a.SingleNested.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.SingleNested.PlanModifiers = append(a.SingleNested.PlanModifiers, specschema.ObjectPlanModifier{Custom: m})
+		}
+	case a.String != nil:
+		if m := requiresReplace(a.String.ComputedOptionalRequired, "stringplanmodifier", a.String.PlanModifiers.CustomPlanModifiers()); m != nil {
+			a.String.PlanModifiers = append(a.String.PlanModifiers, specschema.StringPlanModifier{Custom: m})
+		}
+	}
+}
+
+// requiresReplace returns the RequiresReplaceIfConfigured plan modifier of the given plan modifier package,
+// or nil when the attribute is computed or already declares it.
+func requiresReplace(c specschema.ComputedOptionalRequired, pkg string, declared specschema.CustomPlanModifiers) *specschema.CustomPlanModifier {
+	if c == specschema.Computed {
+		return nil
+	}
+
+	m := &specschema.CustomPlanModifier{
+		Imports: []code.Import{
+			{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/" + pkg},
+		},
+		SchemaDefinition: pkg + ".RequiresReplaceIfConfigured()",
+	}
+
+	for _, d := range declared {
+		if d != nil && d.SchemaDefinition == m.SchemaDefinition {
+			return nil
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,189 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCrudOperations_CreateOnlyAttributes(t *testing.T) {
+	t.Parallel()
+
+	create := &Operation{
+		Method: "POST",
+		Path:   "/products/{product-id}/apis",
+		Fields: []*FieldMapping{
+			{Name: "product-id", StateKey: "product_id", Location: ParameterLocationPath, Required: true},
+			{Name: "apiName", StateKey: "apiName", Location: ParameterLocationBody, Required: true},
+			{Name: "description", StateKey: "description", Location: ParameterLocationBody},
+			{Name: "protocol", StateKey: "protocol", Location: ParameterLocationBody},
+		},
+	}
+
+	testCases := map[string]struct {
+		operations *CrudOperations
+		expected   map[string]bool
+	}{
+		"no-update": {
+			operations: &CrudOperations{Create: create},
+			expected:   map[string]bool{"productid": true, "apiname": true, "description": true, "protocol": true},
+		},
+		"update": {
+			operations: &CrudOperations{
+				Create: create,
+				Update: []*Operation{
+					{
+						Method: "PATCH",
+						Path:   "/products/{product-id}/apis/{api-id}",
+						Fields: []*FieldMapping{
+							{Name: "product-id", StateKey: "product_id", Location: ParameterLocationPath, Required: true},
+							{Name: "api-id", StateKey: "id", Location: ParameterLocationPath, Required: true},
+							{Name: "apiName", StateKey: "apiName", Location: ParameterLocationBody, Required: true},
+						},
+					},
+					{
+						Method: "PUT",
+						Path:   "/products/{product-id}/apis/{api-id}/description",
+						Fields: []*FieldMapping{
+							{Name: "product-id", StateKey: "product_id", Location: ParameterLocationPath, Required: true},
+							{Name: "api-id", StateKey: "id", Location: ParameterLocationPath, Required: true},
+							{Name: "description", StateKey: "description", Location: ParameterLocationBody},
+						},
+					},
+				},
+			},
+			expected: map[string]bool{"productid": true, "protocol": true},
+		},
+		"identifier": {
+			operations: &CrudOperations{
+				Create: &Operation{
+					Method: "PUT",
+					Path:   "/products/{product-id}",
+					Fields: []*FieldMapping{
+						{Name: "product-id", StateKey: "id", Location: ParameterLocationPath, Required: true},
+					},
+				},
+			},
+			expected: map[string]bool{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.operations.CreateOnlyAttributes()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAddRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	requiresReplace := func(pkg string) *specschema.CustomPlanModifier {
+		return &specschema.CustomPlanModifier{
+			Imports:          []code.Import{{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/" + pkg}},
+			SchemaDefinition: pkg + ".RequiresReplaceIfConfigured()",
+		}
+	}
+
+	spec := util.NcloudSpecification{
+		Resources: []util.Resource{
+			{
+				Resource: resource.Resource{
+					Name: "product",
+					Schema: &resource.Schema{
+						Attributes: resource.Attributes{
+							{Name: "product_name", String: &resource.StringAttribute{ComputedOptionalRequired: specschema.Required}},
+							{Name: "subscription_code", String: &resource.StringAttribute{ComputedOptionalRequired: specschema.Required}},
+							{Name: "throttle_rate", Int64: &resource.Int64Attribute{ComputedOptionalRequired: specschema.ComputedOptional}},
+							{Name: "tags", List: &resource.ListAttribute{
+								ComputedOptionalRequired: specschema.Optional,
+								PlanModifiers:            specschema.ListPlanModifiers{{Custom: requiresReplace("listplanmodifier")}},
+							}},
+							{Name: "created_at", String: &resource.StringAttribute{ComputedOptionalRequired: specschema.Computed}},
+						},
+					},
+				},
+				CRUDParameters: util.CrudParameters{
+					Create: &util.NcloudCommonRequestType{
+						Method: "POST",
+						Path:   "/products",
+						DetailedRequestType: util.DetailedRequestType{
+							RequestBody: &util.NcloudRequestBody{
+								Required: []*util.RequestParametersInfo{
+									{Name: "productName", Type: "string"},
+									{Name: "subscriptionCode", Type: "string"},
+								},
+								Optional: []*util.RequestParametersInfo{
+									{Name: "throttleRate", Type: "integer"},
+									{Name: "tags", Type: "array"},
+									{Name: "createdAt", Type: "string"},
+								},
+							},
+						},
+					},
+					Read: &util.NcloudCommonRequestType{
+						Method: "GET",
+						Path:   "/products/{product-id}",
+						DetailedRequestType: util.DetailedRequestType{
+							Parameters: &util.RequestParameters{
+								Required: []*util.RequestParametersInfo{{Name: "product-id", Type: "string"}},
+							},
+						},
+					},
+					Update: []*util.NcloudCommonRequestType{
+						{
+							Method: "PATCH",
+							Path:   "/products/{product-id}",
+							DetailedRequestType: util.DetailedRequestType{
+								Parameters: &util.RequestParameters{
+									Required: []*util.RequestParametersInfo{{Name: "product-id", Type: "string"}},
+								},
+								RequestBody: &util.NcloudRequestBody{
+									Required: []*util.RequestParametersInfo{{Name: "productName", Type: "string"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := AddRequiresReplace(&spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	attributes := spec.Resources[0].Schema.Attributes
+
+	got := map[string]specschema.CustomPlanModifiers{
+		"product_name":      attributes[0].String.PlanModifiers.CustomPlanModifiers(),
+		"subscription_code": attributes[1].String.PlanModifiers.CustomPlanModifiers(),
+		"throttle_rate":     attributes[2].Int64.PlanModifiers.CustomPlanModifiers(),
+		"tags":              attributes[3].List.PlanModifiers.CustomPlanModifiers(),
+		"created_at":        attributes[4].String.PlanModifiers.CustomPlanModifiers(),
+	}
+
+	expected := map[string]specschema.CustomPlanModifiers{
+		"product_name":      nil,
+		"subscription_code": {requiresReplace("stringplanmodifier")},
+		"throttle_rate":     {requiresReplace("int64planmodifier")},
+		"tags":              {requiresReplace("listplanmodifier")},
+		"created_at":        nil,
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
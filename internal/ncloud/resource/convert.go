@@ -9,12 +9,26 @@ import (
 func NewSchemas(spec util.NcloudSpecification) (map[string]generatorschema.GeneratorSchema, error) {
 	resourceSchemas := make(map[string]generatorschema.GeneratorSchema, len(spec.Resources))
 
+	// the type mapping of the provider applies to every conversion
+	var typeMapping generatorschema.TypeMapping
+
+	if spec.Provider != nil && spec.Provider.TypeMapping != nil {
+		m, err := generatorschema.NewTypeMapping(spec.Provider.TypeMapping.Defaults, spec.Provider.TypeMapping.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		typeMapping = m
+	}
+
 	for _, v := range spec.Resources {
 		s, err := NewSchema(v)
 		if err != nil {
 			return nil, err
 		}
 
+		s.TypeMapping = typeMapping
+
 		resourceSchemas[v.Name] = s
 	}
 
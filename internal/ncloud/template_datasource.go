@@ -3,82 +3,67 @@ package ncloud
 import (
 	"bytes"
 	"fmt"
-	"log"
 	"strings"
 	"text/template"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 )
 
-type DataSourceTemplate struct {
-	spec                 util.NcloudSpecification
-	providerName         string
-	dataSourceName       string
-	packageName          string
-	importStateLogic     string
-	refreshObjectName    string
-	model                string
-	refreshLogic         string
-	endpoint             string
-	readPathParams       string
-	readMethod           string
-	readReqBody          string
-	readMethodName       string
-	readOpOptionalParams string
-	idGetter             string
-	configParams         string
-	funcMap              template.FuncMap
-}
-
-// RenderCreate implements BaseTemplate.
-func (d *DataSourceTemplate) RenderCreate() []byte {
-	panic("Data source doesn't provide this method. Please use the right method.")
-}
-
-// RenderDelete implements BaseTemplate.
-func (d *DataSourceTemplate) RenderDelete() []byte {
-	panic("Data source doesn't provide this method. Please use the right method.")
-}
+var (
+	_ BaseTemplate = &DataSourceTemplate{}
+	_ Reader       = &DataSourceTemplate{}
+)
 
-// RenderImportState implements BaseTemplate.
-func (d *DataSourceTemplate) RenderImportState() []byte {
-	panic("Data source doesn't provide this method. Please use the right method.")
+type DataSourceTemplate struct {
+	spec              util.NcloudSpecification
+	providerName      string
+	dataSourceName    string
+	packageName       string
+	refreshObjectName string
+	model             string
+	refreshLogic      string
+	endpoints         string
+	readOp            *Operation
+	idGetter          string
+	configParams      string
+	funcMap           template.FuncMap
 }
 
 // RenderInitial implements BaseTemplate.
-func (d *DataSourceTemplate) RenderInitial() []byte {
+func (d *DataSourceTemplate) RenderInitial() ([]byte, error) {
 	var b bytes.Buffer
 
 	initialTemplate, err := template.New("").Funcs(d.funcMap).Parse(InitialTemplateDataSource)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering initial: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering initial: %w", err)
 	}
 
 	data := struct {
 		ProviderName   string
 		DataSourceName string
+		Endpoints      string
 	}{
 		ProviderName:   d.providerName,
 		DataSourceName: d.dataSourceName,
+		Endpoints:      d.endpoints,
 	}
 
 	err = initialTemplate.ExecuteTemplate(&b, "Initial_DataSource", data)
 	if err != nil {
-		log.Fatalf("error occurred with generating Initial template: %v", err)
+		return nil, fmt.Errorf("error occurred with generating Initial template: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
 // RenderModel implements BaseTemplate.
-func (d *DataSourceTemplate) RenderModel() []byte {
+func (d *DataSourceTemplate) RenderModel() ([]byte, error) {
 	var b bytes.Buffer
 
 	modelTemplate, err := template.New("").Funcs(d.funcMap).Parse(ModelTemplateDataSource)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering model: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering model: %w", err)
 	}
 
 	data := struct {
@@ -91,19 +76,19 @@ func (d *DataSourceTemplate) RenderModel() []byte {
 
 	err = modelTemplate.ExecuteTemplate(&b, "Model_DataSource", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating Model: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating Model: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-// RenderRead implements BaseTemplate.
-func (d *DataSourceTemplate) RenderRead() []byte {
+// RenderRead implements Reader.
+func (d *DataSourceTemplate) RenderRead() ([]byte, error) {
 	var b bytes.Buffer
 
 	readTemplate, err := template.New("").Funcs(d.funcMap).Parse(ReadTemplateDataSource)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering read: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering read: %w", err)
 	}
 
 	data := struct {
@@ -116,60 +101,56 @@ func (d *DataSourceTemplate) RenderRead() []byte {
 
 	err = readTemplate.ExecuteTemplate(&b, "Read_DataSource", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating Read: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating Read: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
 // RenderRefresh implements BaseTemplate.
-func (d *DataSourceTemplate) RenderRefresh() []byte {
+func (d *DataSourceTemplate) RenderRefresh() ([]byte, error) {
 	var b bytes.Buffer
 
-	refreshTemplate, err := template.New("").Funcs(d.funcMap).Parse(RefreshTemplateDataSource)
+	refreshTemplate, err := parseWithRequestTemplate(d.funcMap, RefreshTemplateDataSource)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering refresh: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering refresh: %w", err)
 	}
 
 	data := struct {
-		PackageName          string
-		ResourceName         string
-		RefreshObjectName    string
-		RefreshLogic         string
-		ReadMethodName       string
-		ReadReqBody          string
-		Endpoint             string
-		ReadPathParams       string
-		ReadOpOptionalParams string
-		IdGetter             string
+		PackageName       string
+		ResourceName      string
+		RefreshObjectName string
+		RefreshLogic      string
+		NeedsAttr         bool
+		ReadOp            *Operation
+		ReadMethodName    string
+		IdGetter          string
 	}{
-		PackageName:          d.packageName,
-		ResourceName:         d.dataSourceName,
-		RefreshObjectName:    d.refreshObjectName,
-		RefreshLogic:         d.refreshLogic,
-		ReadMethodName:       d.readMethodName,
-		ReadReqBody:          d.readReqBody,
-		Endpoint:             d.endpoint,
-		ReadPathParams:       d.readPathParams,
-		ReadOpOptionalParams: d.readOpOptionalParams,
-		IdGetter:             d.idGetter,
+		PackageName:       d.packageName,
+		ResourceName:      d.dataSourceName,
+		RefreshObjectName: d.refreshObjectName,
+		RefreshLogic:      d.refreshLogic,
+		NeedsAttr:         strings.Contains(d.refreshLogic, "attr.Type"),
+		ReadOp:            d.readOp,
+		ReadMethodName:    d.readOp.MethodName,
+		IdGetter:          d.idGetter,
 	}
 
 	err = refreshTemplate.ExecuteTemplate(&b, "Refresh_DataSource", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating Refresh: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating Refresh: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
 // RenderTest implements BaseTemplate.
-func (d *DataSourceTemplate) RenderTest() []byte {
+func (d *DataSourceTemplate) RenderTest() ([]byte, error) {
 	var b bytes.Buffer
 
 	testTemplate, err := template.New("").Funcs(d.funcMap).Parse(TestTemplateDataSource)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering test: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering test: %w", err)
 	}
 
 	data := struct {
@@ -186,177 +167,75 @@ func (d *DataSourceTemplate) RenderTest() []byte {
 
 	err = testTemplate.ExecuteTemplate(&b, "Test_DataSource", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating Test: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating Test: %w", err)
 	}
 
-	return b.Bytes()
-}
-
-// RenderUpdate implements BaseTemplate.
-func (d *DataSourceTemplate) RenderUpdate() []byte {
-	panic("Data source doesn't provide this method. Please use the right method.")
-}
-
-// RenderWait implements BaseTemplate.
-func (d *DataSourceTemplate) RenderWait() []byte {
-	panic("Data source doesn't provide this method. Please use the right method.")
+	return b.Bytes(), nil
 }
 
-func NewDataSources(spec *util.NcloudSpecification, datasourceName, packageName string) BaseTemplate {
-	var b BaseTemplate
+func NewDataSources(spec *util.NcloudSpecification, datasourceName, packageName string) (BaseTemplate, error) {
 	var targetDataSourceRequest *util.DataSource
 
 	d := &DataSourceTemplate{
 		spec:           *spec,
 		dataSourceName: datasourceName,
 		providerName:   spec.Provider.Name,
-		packageName:    packageName,
-		endpoint:       spec.Provider.Endpoint,
+		packageName:    dataSourcePackageName(datasourceName, packageName),
 	}
 
 	d.funcMap = util.CreateFuncMap()
 
+	endpoints, err := makeEndpoints(spec.Provider)
+	if err != nil {
+		return nil, err
+	}
+	d.endpoints = endpoints
+
 	for _, val := range spec.DataSources {
 		if val.Name == datasourceName {
 			targetDataSourceRequest = &val
 		}
 	}
 
+	if targetDataSourceRequest == nil {
+		return nil, fmt.Errorf("data source %s is not defined in the specification", datasourceName)
+	}
+
 	if err := makeDataSourceIndividualValues(d, spec, datasourceName); err != nil {
-		log.Fatalf("error occurred with MakeDataSourceIndividualValues: %v", err)
+		return nil, fmt.Errorf("error occurred with MakeDataSourceIndividualValues: %w", err)
 	}
 
 	if err := makeDataSourceReadOperationLogics(d, targetDataSourceRequest); err != nil {
-		log.Fatalf("error occurred with MakeDataSourceReadOperationLogics: %v", err)
+		return nil, fmt.Errorf("error occurred with MakeDataSourceReadOperationLogics: %w", err)
 	}
 
 	if targetDataSourceRequest.CRUDParameters.Read.Parameters != nil {
 		d.configParams = MakeDataSourceTestTFConfig(targetDataSourceRequest.CRUDParameters.Read.Parameters)
 	}
-	b = d
-	return b
+
+	return d, nil
 }
 
 func makeDataSourceReadOperationLogics(d *DataSourceTemplate, t *util.DataSource) error {
-	var readOpOptionalParams strings.Builder
-	var readReqBody strings.Builder
-
 	if t.CRUDParameters.Read == nil {
 		return fmt.Errorf("read operation is not defined for the data source")
 	}
 
-	if t.CRUDParameters.Read.Parameters.Required != nil {
-		for _, val := range t.CRUDParameters.Read.Parameters.Required {
-
-			switch val.Type {
-			case "string":
-				if _, err := readReqBody.WriteString(fmt.Sprintf(`%[1]s: plan.%[2]s.ValueString(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"); err != nil {
-					return err
-				}
-
-			case "integer":
-				if val.Format != "" {
-					switch val.Format {
-					case "int64":
-						if _, err := readReqBody.WriteString(fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt64(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"); err != nil {
-							return err
-						}
-
-					case "int32":
-						if _, err := readReqBody.WriteString(fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt32(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"); err != nil {
-							return err
-						}
-					}
-				}
-
-			case "number":
-				if _, err := readReqBody.WriteString(fmt.Sprintf(`%[1]s: plan.%[2]s.ValueFloat64(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"); err != nil {
-					return err
-				}
-
-			case "boolean":
-				if _, err := readReqBody.WriteString(fmt.Sprintf(`%[1]s: plan.%[2]s.ValueBool(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"); err != nil {
-					return err
-				}
-			}
-		}
+	readOp, err := NewOperation(t.CRUDParameters.Read)
+	if err != nil {
+		return err
 	}
 
-	if t.CRUDParameters.Read.Parameters.Optional != nil {
-		for _, val := range t.CRUDParameters.Read.Parameters.Optional {
-
-			switch val.Type {
-
-			case "string":
-				if _, err := readOpOptionalParams.WriteString(fmt.Sprintf(`
-				if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-					reqParams.%[1]s = plan.%[1]s.ValueString()
-				}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"); err != nil {
-					return err
-				}
-
-			case "integer":
-				if val.Format != "" {
-					switch val.Format {
-					case "int64":
-						if _, err := readOpOptionalParams.WriteString(fmt.Sprintf(`
-						if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-							reqParams.%[1]s = plan.%[1]s.ValueInt64()
-						}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"); err != nil {
-							return err
-						}
-
-					case "int32":
-						if _, err := readOpOptionalParams.WriteString(fmt.Sprintf(`
-						if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-							reqParams.%[1]s = plan.%[1]s.ValueInt32()
-						}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"); err != nil {
-							return err
-						}
-					}
-				}
-
-			case "number":
-				if _, err := readOpOptionalParams.WriteString(fmt.Sprintf(`
-				if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-					reqParams.%[1]s = plan.%[1]s.ValueFloat64()
-				}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"); err != nil {
-					return err
-				}
-
-			case "boolean":
-				if _, err := readOpOptionalParams.WriteString(fmt.Sprintf(`
-				if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-					reqParams.%[1]s = plan.%[1]s.ValueBool()
-				}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"); err != nil {
-					return err
-				}
-
-			case "array":
-				if _, err := readOpOptionalParams.WriteString(fmt.Sprintf(`
-				if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-					reqParams.%[1]s = plan.%[1]s.ValueString()
-				}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"); err != nil {
-					return err
-				}
-
-			// Array and Object are treated as string with serialization
-			default:
-				if _, err := readOpOptionalParams.WriteString(fmt.Sprintf(`
-				if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-					reqParams.%[1]s = plan.%[1]s.ValueString()
-				}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"); err != nil {
-					return err
-				}
-			}
-		}
+	attributes := make(map[string]string)
+	for _, attribute := range t.Schema.Attributes {
+		attributes[attribute.Name], _, _ = dataSourceModelAttrType(attribute)
 	}
 
-	d.readOpOptionalParams = readOpOptionalParams.String()
-	d.readReqBody = readReqBody.String()
-	d.readPathParams = extractReadPathParams(t.CRUDParameters.Read.Path)
-	d.readMethod = t.CRUDParameters.Read.Method
-	d.readMethodName = strings.ToUpper(t.CRUDParameters.Read.Method) + getMethodName(t.CRUDParameters.Read.Path)
+	if err := NewPathParameters(t.PathParameters, "", attributes).Resolve(readOp); err != nil {
+		return err
+	}
+
+	d.readOp = readOp
 
 	return nil
 }
@@ -366,31 +245,29 @@ func makeDataSourceIndividualValues(d *DataSourceTemplate, spec *util.NcloudSpec
 
 	for _, datasource := range spec.DataSources {
 		if datasource.Name == datasourceName {
-			d.idGetter = makeIdGetter(datasource.Id)
+			d.idGetter = makeResponseValueGetter(datasource.Id, datasourceName)
 			d.refreshObjectName = datasource.RefreshObjectName
 			attributes = datasource.Schema.Attributes
-			d.importStateLogic = makeImportStateLogic(datasource.ImportStateOverride)
 		}
 	}
 
 	_, model, err := Gen_ConvertOAStoTFTypes_Datasource(attributes)
 	if err != nil {
-		return fmt.Errorf("error occurred with Gen_ConvertOAStoTFTypes: %v", err)
+		return fmt.Errorf("error occurred with Gen_ConvertOAStoTFTypes: %w", err)
 	}
 
 	d.model = model
+	d.refreshLogic = MakeDataSourceRefreshFromResponse(attributes, datasourceName)
 	return nil
 }
 
-// Data source test file only requires
-func MakeDataSourceTestTFConfig(readParams *util.RequestParameters) string {
-	var t strings.Builder
+// makeResponseValueGetter returns the expression reading a value from the read response as a string, following the dotted path of target.
+func makeResponseValueGetter(target, name string) string {
+	var path []string
 
-	if readParams != nil {
-		for _, val := range readParams.Required {
-			t.WriteString(fmt.Sprintf(`		%[1]s = "%[2]s"`, PascalToSnakeCase(val.Name), "tf-"+acctest.RandString(5)) + "\n")
-		}
+	for _, val := range strings.Split(target, ".") {
+		path = append(path, fmt.Sprintf("%q", PascalToSnakeCase(val)))
 	}
 
-	return t.String()
+	return fmt.Sprintf("ncloudsdk.ValueString(ncloudsdk.AttributeAt(response.%s, %s))", util.ToPascalCase(name), strings.Join(path, ", "))
 }
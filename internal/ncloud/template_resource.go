@@ -3,188 +3,196 @@ package ncloud
 import (
 	"bytes"
 	"fmt"
-	"log"
+	"regexp"
 	"strings"
 	"text/template"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 )
 
-// To generate actual data, extract data from config.yml and code-spec.json, and render code for each receiver based on that data.
+// BaseTemplate holds what every kind of template renders. Additional capabilities are provided through
+// Reader, Writer, Importer and Waiter, and are checked by type assertion.
 type BaseTemplate interface {
 
 	// RenderInitial generates small code blocks needed initially.
-	RenderInitial() []byte
+	RenderInitial() ([]byte, error)
 
-	// RenderCreate generates the Create function.
-	RenderCreate() []byte
+	// RenderModel generates the model.
+	RenderModel() ([]byte, error)
+
+	// RenderRefresh generates the Refresh function.
+	RenderRefresh() ([]byte, error)
+
+	// RenderTest generates the Test logic.
+	RenderTest() ([]byte, error)
+}
+
+// Reader is implemented by templates which generate the Read function.
+type Reader interface {
 
 	// RenderRead generates the Read function.
-	RenderRead() []byte
+	RenderRead() ([]byte, error)
+}
+
+// Writer is implemented by templates which manage the lifecycle of a remote object.
+type Writer interface {
+
+	// RenderCreate generates the Create function.
+	RenderCreate() ([]byte, error)
 
 	// RenderUpdate generates the Update function.
-	RenderUpdate() []byte
+	RenderUpdate() ([]byte, error)
 
 	// RenderDelete generates the Delete function.
-	RenderDelete() []byte
+	RenderDelete() ([]byte, error)
+}
 
-	// RenderModel generates the model.
-	RenderModel() []byte
+// Importer is implemented by templates which support terraform import.
+type Importer interface {
 
-	// RenderRefresh generates the Refresh function.
-	RenderRefresh() []byte
+	// RenderImportState generates the ImportState function.
+	RenderImportState() ([]byte, error)
+}
 
-	// RenderWait generates the Waiting Logic.
-	// Will be Rendered in refresh file.
-	RenderWait() []byte
+// MockTester is implemented by templates which generate unit tests against a mock API server.
+type MockTester interface {
 
-	// RenderTest generates the Test logic.
-	RenderTest() []byte
+	// RenderMockTest generates the mock API server and the unit test driving the resource through it.
+	RenderMockTest() ([]byte, error)
+}
 
-	// RenderImportState generates the ImportState function.
-	RenderImportState() []byte
+// Waiter is implemented by templates which wait for the remote object to reach a state.
+type Waiter interface {
+
+	// RenderWait generates the Waiting Logic.
+	// Will be Rendered in refresh file.
+	RenderWait() ([]byte, error)
 }
 
+var (
+	_ BaseTemplate = &Template{}
+	_ Reader       = &Template{}
+	_ Writer       = &Template{}
+	_ Importer     = &Template{}
+	_ Waiter       = &Template{}
+	_ MockTester   = &Template{}
+)
+
 type Template struct {
-	spec                       util.NcloudSpecification
-	providerName               string
-	resourceName               string
-	packageName                string
-	importStateLogic           string
-	refreshObjectName          string
-	model                      string
-	refreshLogic               string
-	refreshWithResponse        string
-	endpoint                   string
-	deletePathParams           string
-	updatePathParams           string
-	readPathParams             string
-	createPathParams           string
-	deleteMethod               string
-	updateMethod               string
-	readMethod                 string
-	createMethod               string
-	createReqBody              string
-	createReqListParams        string
-	createReqObjectParams      string
-	updateReqBody              string
-	updateReqListParams        string
-	updateReqObjectParams      string
-	readReqBody                string
-	deleteReqBody              string
-	createMethodName           string
-	readMethodName             string
-	updateMethodName           string
-	deleteMethodName           string
-	idGetter                   string
-	funcMap                    template.FuncMap
-	configParams               string
-	readReqBodyForCheckExist   string
-	readReqBodyForCheckDestroy string
-	isUpdateExists             bool
-	createOpOptionalParams     string
-	updateOpOptionalParams     string
-	readOpOptionalParams       string
+	spec                util.NcloudSpecification
+	providerName        string
+	resourceName        string
+	packageName         string
+	refreshObjectName   string
+	model               string
+	refreshWithResponse string
+	endpoints           string
+	operations          *CrudOperations
+	id                  string
+	idGetter            string
+	funcMap             template.FuncMap
+	createStep          *TestStep
+	updateStep          *TestStep
+	importState         *ImportState
+	isUpdateExists      bool
+	wait                *Wait
+	waitConfig          *util.WaitConfig
+	timeouts            *Timeouts
 }
 
-func (t *Template) RenderInitial() []byte {
+func (t *Template) RenderInitial() ([]byte, error) {
 	var b bytes.Buffer
 
 	initialTemplate, err := template.New("").Funcs(t.funcMap).Parse(InitialTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering initial: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering initial: %w", err)
 	}
 
 	data := struct {
 		ProviderName string
 		ResourceName string
+		Endpoints    string
+		Timeouts     *Timeouts
 	}{
 		ProviderName: t.providerName,
 		ResourceName: t.resourceName,
+		Endpoints:    t.endpoints,
+		Timeouts:     t.timeouts,
 	}
 
 	err = initialTemplate.ExecuteTemplate(&b, "Initial", data)
 	if err != nil {
-		log.Fatalf("error occurred with generating Initial template: %v", err)
+		return nil, fmt.Errorf("error occurred with generating Initial template: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-func (t *Template) RenderImportState() []byte {
+func (t *Template) RenderImportState() ([]byte, error) {
 	var b bytes.Buffer
 
 	initialTemplate, err := template.New("").Funcs(t.funcMap).Parse(ImportStateTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering initial: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering initial: %w", err)
 	}
 
 	data := struct {
-		ResourceName     string
-		ImportStateLogic string
+		ResourceName string
+		ImportState  *ImportState
 	}{
-		ResourceName:     t.resourceName,
-		ImportStateLogic: t.importStateLogic,
+		ResourceName: t.resourceName,
+		ImportState:  t.importState,
 	}
 
 	err = initialTemplate.ExecuteTemplate(&b, "ImportState", data)
 	if err != nil {
-		log.Fatalf("error occurred with generating ImportState template: %v", err)
+		return nil, fmt.Errorf("error occurred with generating ImportState template: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-func (t *Template) RenderCreate() []byte {
+func (t *Template) RenderCreate() ([]byte, error) {
 	var b bytes.Buffer
 
-	createTemplate, err := template.New("").Funcs(t.funcMap).Parse(CreateTemplate)
+	createTemplate, err := parseWithRequestTemplate(t.funcMap, CreateTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering create: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering create: %w", err)
 	}
 
 	data := struct {
-		ResourceName           string
-		RefreshObjectName      string
-		CreateReqBody          string
-		CreateReqListParam     string
-		CreateReqObjectParam   string
-		CreateReqOptionalParam string
-		CreateMethod           string
-		CreateMethodName       string
-		Endpoint               string
-		CreatePathParams       string
-		IdGetter               string
+		ResourceName      string
+		RefreshObjectName string
+		CreateOp          *Operation
+		CreateMethod      string
+		CreateMethodName  string
+		IdGetter          string
+		Timeouts          *Timeouts
 	}{
-		ResourceName:           t.resourceName,
-		RefreshObjectName:      t.refreshObjectName,
-		CreateReqBody:          t.createReqBody,
-		CreateReqListParam:     t.createReqListParams,
-		CreateReqObjectParam:   t.createReqObjectParams,
-		CreateReqOptionalParam: t.createOpOptionalParams,
-		CreateMethod:           t.createMethod,
-		CreateMethodName:       t.createMethodName,
-		Endpoint:               t.endpoint,
-		CreatePathParams:       t.createPathParams,
-		IdGetter:               t.idGetter,
+		ResourceName:      t.resourceName,
+		RefreshObjectName: t.refreshObjectName,
+		CreateOp:          t.operations.Create,
+		CreateMethod:      t.operations.Create.Method,
+		CreateMethodName:  t.operations.Create.MethodName,
+		IdGetter:          t.idGetter,
+		Timeouts:          t.timeouts,
 	}
 
 	err = createTemplate.ExecuteTemplate(&b, "Create", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating Create: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating Create: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-func (t *Template) RenderRead() []byte {
+func (t *Template) RenderRead() ([]byte, error) {
 	var b bytes.Buffer
 
 	readTemplate, err := template.New("").Funcs(t.funcMap).Parse(ReadTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering read: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering read: %w", err)
 	}
 
 	data := struct {
@@ -197,226 +205,255 @@ func (t *Template) RenderRead() []byte {
 
 	err = readTemplate.ExecuteTemplate(&b, "Read", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating Read: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating Read: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-func (t *Template) RenderUpdate() []byte {
+func (t *Template) RenderUpdate() ([]byte, error) {
 	var b bytes.Buffer
 
-	updateTemplate, err := template.New("").Funcs(t.funcMap).Parse(UpdateTemplate)
+	updateTemplate, err := parseWithRequestTemplate(t.funcMap, UpdateTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering update: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering update: %w", err)
 	}
 
 	data := struct {
-		IsUpdateExists         bool
-		ResourceName           string
-		RefreshObjectName      string
-		UpdateReqBody          string
-		UpdateReqListParam     string
-		UpdateReqObjectParam   string
-		UpdateReqOptionalParam string
-		UpdateMethod           string
-		UpdateMethodName       string
-		Endpoint               string
-		UpdatePathParams       string
-		ReadPathParams         string
+		IsUpdateExists    bool
+		ResourceName      string
+		RefreshObjectName string
+		UpdateOps         []*Operation
+		IsUpdateWaited    bool
+		Timeouts          *Timeouts
 	}{
-		IsUpdateExists:         t.isUpdateExists,
-		ResourceName:           t.resourceName,
-		RefreshObjectName:      t.refreshObjectName,
-		UpdateReqBody:          t.updateReqBody,
-		UpdateReqListParam:     t.updateReqListParams,
-		UpdateReqObjectParam:   t.updateReqObjectParams,
-		UpdateReqOptionalParam: t.updateOpOptionalParams,
-		UpdateMethod:           t.updateMethod,
-		UpdateMethodName:       t.updateMethodName,
-		Endpoint:               t.endpoint,
-		UpdatePathParams:       t.updatePathParams,
-		ReadPathParams:         t.readPathParams,
+		IsUpdateExists:    t.isUpdateExists,
+		ResourceName:      t.resourceName,
+		RefreshObjectName: t.refreshObjectName,
+		UpdateOps:         t.operations.Update,
+		IsUpdateWaited:    t.wait.Update != nil,
+		Timeouts:          t.timeouts,
 	}
 
 	err = updateTemplate.ExecuteTemplate(&b, "Update", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating Update: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating Update: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-func (t *Template) RenderDelete() []byte {
+func (t *Template) RenderDelete() ([]byte, error) {
 	var b bytes.Buffer
 
-	deleteTemplate, err := template.New("").Funcs(t.funcMap).Parse(DeleteTemplate)
+	deleteTemplate, err := parseWithRequestTemplate(t.funcMap, DeleteTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering delete: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering delete: %w", err)
 	}
 
 	data := struct {
 		ResourceName      string
 		RefreshObjectName string
+		DeleteOp          *Operation
 		DeleteMethod      string
-		DeleteReqBody     string
 		DeleteMethodName  string
-		Endpoint          string
-		DeletePathParams  string
 		IdGetter          string
+		Timeouts          *Timeouts
 	}{
 		ResourceName:      t.resourceName,
 		RefreshObjectName: t.refreshObjectName,
-		DeleteMethod:      t.deleteMethod,
-		DeleteReqBody:     t.deleteReqBody,
-		DeleteMethodName:  t.deleteMethodName,
-		Endpoint:          t.endpoint,
-		DeletePathParams:  t.deletePathParams,
+		DeleteOp:          t.operations.Delete,
+		DeleteMethod:      t.operations.Delete.Method,
+		DeleteMethodName:  t.operations.Delete.MethodName,
 		IdGetter:          t.idGetter,
+		Timeouts:          t.timeouts,
 	}
 
 	err = deleteTemplate.ExecuteTemplate(&b, "Delete", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating delete: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating delete: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-func (t *Template) RenderModel() []byte {
+func (t *Template) RenderModel() ([]byte, error) {
 	var b bytes.Buffer
 
 	modelTemplate, err := template.New("").Funcs(t.funcMap).Parse(ModelTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering model: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering model: %w", err)
 	}
 
 	data := struct {
 		RefreshObjectName string
 		Model             string
+		Timeouts          *Timeouts
 	}{
 		RefreshObjectName: t.refreshObjectName,
 		Model:             t.model,
+		Timeouts:          t.timeouts,
 	}
 
 	err = modelTemplate.ExecuteTemplate(&b, "Model", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating Model: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating Model: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-func (t *Template) RenderRefresh() []byte {
+func (t *Template) RenderRefresh() ([]byte, error) {
 	var b bytes.Buffer
 
-	refreshTemplate, err := template.New("").Funcs(t.funcMap).Parse(RefreshTemplate)
+	refreshTemplate, err := parseWithRequestTemplate(t.funcMap, RefreshTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering refresh: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering refresh: %w", err)
 	}
 
 	data := struct {
 		PackageName         string
 		RefreshObjectName   string
 		RefreshWithResponse string
-		Endpoint            string
 		CreateMethodName    string
+		ReadOp              *Operation
 		ReadMethodName      string
-		ReadReqBody         string
 		IdGetter            string
+		Timeouts            *Timeouts
 	}{
 		PackageName:         t.packageName,
 		RefreshObjectName:   t.refreshObjectName,
 		RefreshWithResponse: t.refreshWithResponse,
-		Endpoint:            t.endpoint,
-		CreateMethodName:    t.createMethodName,
-		ReadMethodName:      t.readMethodName,
-		ReadReqBody:         t.readReqBody,
+		CreateMethodName:    t.operations.Create.MethodName,
+		ReadOp:              t.operations.Read,
+		ReadMethodName:      t.operations.Read.MethodName,
 		IdGetter:            t.idGetter,
+		Timeouts:            t.timeouts,
 	}
 
 	err = refreshTemplate.ExecuteTemplate(&b, "Refresh", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating Refresh: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating Refresh: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-func (t *Template) RenderWait() []byte {
+func (t *Template) RenderWait() ([]byte, error) {
 	var b bytes.Buffer
 
-	waitTemplate, err := template.New("").Funcs(t.funcMap).Parse(WaitTemplate)
+	waitTemplate, err := parseWithRequestTemplate(t.funcMap, WaitTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering wait: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering wait: %w", err)
 	}
 
 	data := struct {
+		ReadOp            *Operation
 		ReadMethod        string
 		ReadMethodName    string
-		Endpoint          string
-		ReadPathParams    string
 		RefreshObjectName string
-		ReadReqBody       string
+		Wait              *Wait
+		Timeouts          *Timeouts
 	}{
-		ReadMethod:        t.readMethod,
-		ReadMethodName:    t.readMethodName,
-		Endpoint:          t.endpoint,
-		ReadPathParams:    t.readPathParams,
+		ReadOp:            t.operations.Read,
+		ReadMethod:        t.operations.Read.Method,
+		ReadMethodName:    t.operations.Read.MethodName,
 		RefreshObjectName: t.refreshObjectName,
-		ReadReqBody:       t.readReqBody,
+		Wait:              t.wait,
+		Timeouts:          t.timeouts,
 	}
 
 	err = waitTemplate.ExecuteTemplate(&b, "Wait", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating wait: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating wait: %w", err)
 	}
 
-	return b.Bytes()
+	return b.Bytes(), nil
 }
 
-func (t *Template) RenderTest() []byte {
+func (t *Template) RenderTest() ([]byte, error) {
 	var b bytes.Buffer
 
-	testTemplate, err := template.New("").Funcs(t.funcMap).Parse(TestTemplate)
+	testTemplate, err := parseWithRequestTemplate(t.funcMap, TestTemplate)
 	if err != nil {
-		log.Fatalf("error occurred with baseTemplate at rendering test: %v", err)
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering test: %w", err)
 	}
 
 	data := struct {
-		ProviderName               string
-		ResourceName               string
-		PackageName                string
-		RefreshObjectName          string
-		ReadMethod                 string
-		ReadMethodName             string
-		ReadReqBody                string
-		Endpoint                   string
-		ReadPathParams             string
-		ConfigParams               string
-		ReadReqBodyForCheckExist   string
-		ReadReqBodyForCheckDestroy string
+		ProviderName      string
+		ResourceName      string
+		PackageName       string
+		RefreshObjectName string
+		ReadOp            *Operation
+		ReadMethod        string
+		ReadMethodName    string
+		Endpoints         string
+		CreateStep        *TestStep
+		UpdateStep        *TestStep
+		ImportState       *ImportState
+		IgnoreTimeouts    bool
 	}{
-		ProviderName:               t.providerName,
-		ResourceName:               t.resourceName,
-		PackageName:                t.packageName,
-		RefreshObjectName:          t.refreshObjectName,
-		ReadMethod:                 t.readMethod,
-		ReadMethodName:             t.readMethodName,
-		ReadReqBody:                t.readReqBody,
-		Endpoint:                   t.endpoint,
-		ReadPathParams:             t.readPathParams,
-		ConfigParams:               t.configParams,
-		ReadReqBodyForCheckExist:   t.readReqBodyForCheckExist,
-		ReadReqBodyForCheckDestroy: t.readReqBodyForCheckDestroy,
+		ProviderName:      t.providerName,
+		ResourceName:      t.resourceName,
+		PackageName:       t.packageName,
+		RefreshObjectName: t.refreshObjectName,
+		ReadOp:            t.operations.Read,
+		ReadMethod:        t.operations.Read.Method,
+		ReadMethodName:    t.operations.Read.MethodName,
+		Endpoints:         t.endpoints,
+		CreateStep:        t.createStep,
+		UpdateStep:        t.updateStep,
+		ImportState:       t.importState,
+		IgnoreTimeouts:    t.timeouts != nil,
 	}
 
 	err = testTemplate.ExecuteTemplate(&b, "Test", data)
 	if err != nil {
-		log.Fatalf("error occurred with Generating test: %v", err)
+		return nil, fmt.Errorf("error occurred with Generating test: %w", err)
+	}
+
+	return b.Bytes(), nil
+}
+
+func (t *Template) RenderMockTest() ([]byte, error) {
+	var b bytes.Buffer
+
+	mock, err := NewMock(t.operations, t.id, t.waitConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	mockTemplate, err := template.New("").Funcs(t.funcMap).Parse(MockTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("error occurred with baseTemplate at rendering mock test: %w", err)
+	}
+
+	data := struct {
+		ProviderName   string
+		ResourceName   string
+		PackageName    string
+		Mock           *Mock
+		CreateStep     *TestStep
+		UpdateStep     *TestStep
+		ImportState    *ImportState
+		IgnoreTimeouts bool
+	}{
+		ProviderName:   t.providerName,
+		ResourceName:   t.resourceName,
+		PackageName:    t.packageName,
+		Mock:           mock,
+		CreateStep:     t.createStep,
+		UpdateStep:     t.updateStep,
+		ImportState:    t.importState,
+		IgnoreTimeouts: t.timeouts != nil,
 	}
 
-	return b.Bytes()
+	err = mockTemplate.ExecuteTemplate(&b, "Mock", data)
+	if err != nil {
+		return nil, fmt.Errorf("error occurred with Generating mock test: %w", err)
+	}
+
+	return b.Bytes(), nil
 }
 
 type RequestType struct {
@@ -432,25 +469,11 @@ type OptionalRequestBody struct {
 }
 
 // Extracts the data needed for code generation. Currently, it extracts data from config.yml and code-spec.json, but it is planned to unify everything into code-spec.json in the future.
-func NewResource(spec util.NcloudSpecification, resourceName, packageName string) BaseTemplate {
-	var b BaseTemplate
+func NewResource(spec util.NcloudSpecification, resourceName, packageName string) (BaseTemplate, error) {
 	var refreshObjectName string
 	var id string
 	var attributes resource.Attributes
-	var createReqBody string
-	var createReqListParams string
-	var createReqObjectParams string
-	var createOpOptionalParams string
-	var updateReqBody string
-	var updateReqListParams string
-	var updateReqObjectParams string
-	var updateOpOptionalParams string
-	var readReqBody string
-	var readReqBodyForCheckExist string
-	var readReqBodyForCheckDestroy string
-	var deleteReqBody string
-	var readOpOptionalParams string
-	var importStateOverride string
+	var importStateOverride *util.ImportStateOverride
 	var targetResourceRequest *util.Resource
 
 	t := &Template{
@@ -475,522 +498,68 @@ func NewResource(spec util.NcloudSpecification, resourceName, packageName string
 		}
 	}
 
-	refreshLogic, model, err := Gen_ConvertOAStoTFTypes_Resource(attributes)
-	if err != nil {
-		log.Fatalf("error occurred with Gen_ConvertOAStoTFTypes: %v", err)
+	if targetResourceRequest == nil {
+		return nil, fmt.Errorf("resource %s is not defined in the specification", resourceName)
 	}
 
-	// Address Request > Create
-	if targetResourceRequest.CRUDParameters.Create != nil {
-		// Address Request > Create > RequestBody
-		if targetResourceRequest.CRUDParameters.Create.RequestBody != nil {
-
-			if targetResourceRequest.CRUDParameters.Create.RequestBody.Required != nil {
-				for _, val := range targetResourceRequest.CRUDParameters.Create.RequestBody.Required {
-
-					switch val.Type {
-					case "string":
-						createReqBody = createReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueString(),`, util.FirstAlphabetToUpperCase(val.Name), util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "integer":
-						switch val.Format {
-						case "int64":
-							createReqBody = createReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt64(),`, util.FirstAlphabetToUpperCase(val.Name), util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-						case "int32":
-							createReqBody = createReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt32(),`, util.FirstAlphabetToUpperCase(val.Name), util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-						}
-
-					case "number":
-						createReqBody = createReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueFloat64(),`, util.FirstAlphabetToUpperCase(val.Name), util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "boolean":
-						createReqBody = createReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueBool(),`, util.FirstAlphabetToUpperCase(val.Name), util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "array":
-						createReqListParams = createReqListParams + fmt.Sprintf(`
-					list%[1]s, diags := types.ListValue(
-						plan.%[1]s.ElementType(ctx),
-						plan.%[1]s.Elements(),
-					)
-					resp.Diagnostics.Append(diags...)
-					if resp.Diagnostics.HasError() {
-						return
-					}
-	
-					reqParams.%[1]s = list%[1]s
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "object":
-						createReqObjectParams = createReqObjectParams + fmt.Sprintf(`
-					obj%[1]s, diags := types.ObjectValue(
-						plan.%[1]s.AttributeTypes(ctx),
-						plan.%[1]s.Attributes(),
-					)
-					resp.Diagnostics.Append(diags...)
-					if resp.Diagnostics.HasError() {
-						return
-					}
-	
-					reqParams.%[1]s= obj%[1]s
-					`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-					}
-
-				}
-			}
-
-			if targetResourceRequest.CRUDParameters.Create.RequestBody.Optional != nil {
-				for _, val := range targetResourceRequest.CRUDParameters.Create.RequestBody.Optional {
-
-					switch val.Type {
-					case "string":
-						createOpOptionalParams = createOpOptionalParams + fmt.Sprintf(`
-					if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-						reqParams.%[1]s = plan.%[1]s.ValueString()
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "integer":
-
-						switch val.Format {
-						case "int64":
-							createOpOptionalParams = createOpOptionalParams + fmt.Sprintf(`
-							if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-								reqParams.%[1]s = plan.%[1]s.ValueInt64()
-							}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-						case "int32":
-							createOpOptionalParams = createOpOptionalParams + fmt.Sprintf(`
-							if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-								reqParams.%[1]s = plan.%[1]s.ValueInt32()
-							}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-						}
-
-					case "number":
-						createOpOptionalParams = createOpOptionalParams + fmt.Sprintf(`
-					if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-						reqParams.%[1]s = plan.%[1]s.ValueFloat64()
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "boolean":
-						createOpOptionalParams = createOpOptionalParams + fmt.Sprintf(`
-					if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-						reqParams.%[1]s = plan.%[1]s.ValueBool()
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "array":
-						createOpOptionalParams = createOpOptionalParams + fmt.Sprintf(`
-					if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-						list%[1]s, diags := types.ListValue(
-							plan.%[1]s.ElementType(ctx),
-							plan.%[1]s.Elements(),
-						)
-						resp.Diagnostics.Append(diags...)
-						if resp.Diagnostics.HasError() {
-							return
-						}
-
-						reqParams.%[1]s = list%[1]s
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "object":
-						createOpOptionalParams = createOpOptionalParams + fmt.Sprintf(`
-					if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-						obj%[1]s, diags := types.ObjectValue(
-							plan.%[1]s.AttributeTypes(ctx),
-							plan.%[1]s.Attributes(),
-						)
-						resp.Diagnostics.Append(diags...)
-						if resp.Diagnostics.HasError() {
-							return
-						}
-
-						reqParams.%[1]s= obj%[1]s
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					// Array and Object are treated as string with serialization
-					default:
-						createOpOptionalParams = createOpOptionalParams + fmt.Sprintf(`
-					if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-						reqParams.%[1]s = plan.%[1]s.ValueString()
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-					}
-				}
-			}
-
-			if targetResourceRequest.CRUDParameters.Create != nil {
-				t.configParams = MakeTestTFConfig(targetResourceRequest.CRUDParameters.Create)
-			}
-
-			t.createPathParams = extractPathParams(targetResourceRequest.CRUDParameters.Create.Path)
-			t.createMethod = targetResourceRequest.CRUDParameters.Create.Method
-			t.createMethodName = strings.ToUpper(targetResourceRequest.CRUDParameters.Create.Method) + getMethodName(targetResourceRequest.CRUDParameters.Create.Path)
-		}
-
-		if targetResourceRequest.CRUDParameters.Create.Parameters != nil {
-
-			if targetResourceRequest.CRUDParameters.Create.Parameters.Required != nil {
-				for _, val := range targetResourceRequest.CRUDParameters.Create.Parameters.Required {
-					switch val.Type {
-					case "string":
-						createReqBody = createReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueString(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-					case "integer":
-						if val.Format != "" {
-							switch val.Format {
-							case "int64":
-								createReqBody = createReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt64(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-							case "int32":
-								createReqBody = createReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt32(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-							}
-						}
+	crud := targetResourceRequest.CRUDParameters
 
-					case "number":
-						createReqBody = createReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueFloat64(,`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-					case "boolean":
-						createReqBody = createReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueBool(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-					}
-				}
-			}
+	operations, err := NewResourceOperations(targetResourceRequest)
+	if err != nil {
+		return nil, err
+	}
 
-			if targetResourceRequest.CRUDParameters.Create.Parameters.Optional != nil {
-				// NOTE - CREATE does not have optional parameters
-				panic("CREATE does not have optional Parameters in the previous cases. Please notify the developer to implement.")
-			}
+	wait, err := NewWait(targetResourceRequest.Wait, resourceName)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	timeouts, err := NewTimeouts(targetResourceRequest.Timeouts)
+	if err != nil {
+		return nil, err
+	}
 
-		t.createReqListParams = createReqListParams
-		t.createReqObjectParams = createReqObjectParams
+	importState, err := NewImportState(importStateOverride)
+	if err != nil {
+		return nil, err
 	}
 
-	// Address Request > Read
-	if targetResourceRequest.CRUDParameters.Read != nil {
-		// Address Request > Read > Parameters (READ does not have RequestBody)
-		if targetResourceRequest.CRUDParameters.Read.Parameters != nil {
-
-			for _, val := range targetResourceRequest.CRUDParameters.Read.Parameters.Required {
-
-				switch val.Type {
-				case "string":
-					readReqBody = readReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueString(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-				case "integer":
-					if val.Format != "" {
-						switch val.Format {
-						case "int64":
-							readReqBody = readReqBody + fmt.Sprintf(`%[1]s: int(plan.%[2]s.ValueInt64(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-						case "int32":
-							readReqBody = readReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt32(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-						}
-					}
-
-				case "number":
-					readReqBody = readReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueFloat64(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-				case "boolean":
-					readReqBody = readReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueBool(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-				}
-
-				readReqBodyForCheckExist = readReqBodyForCheckExist + fmt.Sprintf(`		%[1]s: resource.Primary.Attributes["%[2]s"],`, util.PathToPascal(val.Name), util.FirstAlphabetToLowerCase(util.PathToPascal(val.Name))) + "\n"
-				readReqBodyForCheckDestroy = readReqBodyForCheckDestroy + fmt.Sprintf(`		%[1]s: rs.Primary.Attributes["%[2]s"],`, util.PathToPascal(val.Name), util.FirstAlphabetToLowerCase(util.PathToPascal(val.Name))) + "\n"
-			}
-
-			if targetResourceRequest.CRUDParameters.Read.Parameters.Optional != nil {
-				for _, val := range targetResourceRequest.CRUDParameters.Read.Parameters.Optional {
-
-					switch val.Type {
-					case "string":
-						readOpOptionalParams = readOpOptionalParams + fmt.Sprintf(`
-					if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-						reqParams.%[1]s = plan.%[1]s.ValueString()
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "integer":
-						if val.Format != "" {
-							switch val.Format {
-							case "int64":
-								readOpOptionalParams = readOpOptionalParams + fmt.Sprintf(`
-							if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-								reqParams.%[1]s = plan.%[1]s.ValueInt64()
-							}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-							case "int32":
-								readOpOptionalParams = readOpOptionalParams + fmt.Sprintf(`
-							if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-								reqParams.%[1]s = plan.%[1]s.ValueInt32()
-							}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-							}
-						}
-
-					case "number":
-						readOpOptionalParams = readOpOptionalParams + fmt.Sprintf(`
-					if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-						reqParams.%[1]s = plan.%[1]s.ValueFloat64()
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "boolean":
-						readOpOptionalParams = readOpOptionalParams + fmt.Sprintf(`
-					if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-						reqParams.%[1]s = plan.%[1]s.ValueBool()
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "array":
-						readOpOptionalParams = readOpOptionalParams + fmt.Sprintf(`
-					if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-						reqParams.%[1]s = plan.%[1]s.ValueString()
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-						// Array and Object are treated as string with serialization
-					default:
-						readOpOptionalParams = readOpOptionalParams + fmt.Sprintf(`
-					if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-						reqParams.%[1]s = plan.%[1]s.ValueString()
-					}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-					}
-				}
-			}
-		}
+	idGetter, err := makeIdGetter(id)
+	if err != nil {
+		return nil, err
+	}
 
-		t.readPathParams = extractReadPathParams(targetResourceRequest.CRUDParameters.Read.Path)
-		t.readMethod = targetResourceRequest.CRUDParameters.Read.Method
-		t.readMethodName = strings.ToUpper(targetResourceRequest.CRUDParameters.Read.Method) + getMethodName(targetResourceRequest.CRUDParameters.Read.Path)
+	endpoints, err := makeEndpoints(spec.Provider)
+	if err != nil {
+		return nil, err
 	}
 
 	// Address Request > Update
-	if len(targetResourceRequest.CRUDParameters.Update) > 0 {
-
-		// Address Request > Update > Parameters
-		if targetResourceRequest.CRUDParameters.Update[0].Parameters != nil {
-
-			if targetResourceRequest.CRUDParameters.Update[0].Parameters.Required != nil {
-				for _, val := range targetResourceRequest.CRUDParameters.Update[0].Parameters.Required {
-					switch val.Type {
-					case "string":
-						updateReqBody = updateReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueString(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-					case "integer":
-						if val.Format != "" {
-							switch val.Format {
-							case "int64":
-								updateReqBody = updateReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt64(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-							case "int32":
-								updateReqBody = updateReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt32(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-							}
-						}
-
-					case "number":
-						updateReqBody = updateReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueFloat64()),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-					case "boolean":
-						updateReqBody = updateReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueBool(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-					case "array":
-						updateReqListParams = updateReqListParams + fmt.Sprintf(`
-						list%[1]s, diags := types.ListValue(
-							plan.%[1]s.ElementType(ctx),
-							plan.%[1]s.Elements(),
-						)
-						resp.Diagnostics.Append(diags...)
-						if resp.Diagnostics.HasError() {
-							return
-						}
-		
-						reqParams.%[1]s = list%[1]s
-						}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "object":
-						updateReqObjectParams = updateReqObjectParams + fmt.Sprintf(`
-						obj%[1]s, diags := types.ObjectValue(
-							plan.%[1]s.AttributeTypes(ctx),
-							plan.%[1]s.Attributes(),
-						)
-						resp.Diagnostics.Append(diags...)
-						if resp.Diagnostics.HasError() {
-							return
-						}
-		
-						reqParams.%[1]s= obj%[1]s
-						`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-					}
-				}
-			}
-
-			if targetResourceRequest.CRUDParameters.Update[0].Parameters.Optional != nil {
-				// NOTE - UPDATE does not have optional parameters
-				panic("UPDATE does not have optional Parameters in the previous cases. Please notify the developer to implement.")
-			}
-
-		}
-
-		if targetResourceRequest.CRUDParameters.Update[0].RequestBody != nil {
-
-			if targetResourceRequest.CRUDParameters.Update[0].RequestBody.Required != nil {
-				for _, val := range targetResourceRequest.CRUDParameters.Update[0].RequestBody.Required {
-					switch val.Type {
-					case "string":
-						updateReqBody = updateReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueString(),`, util.FirstAlphabetToUpperCase(val.Name), util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "integer":
-						if val.Format != "" {
-							switch val.Format {
-							case "int64":
-								updateReqBody = updateReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt64(),`, util.FirstAlphabetToUpperCase(val.Name), util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-							case "int32":
-								updateReqBody = updateReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt32(),`, util.FirstAlphabetToUpperCase(val.Name), util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-							}
-						}
-
-					case "number":
-						updateReqBody = updateReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueFloat64(),`, util.FirstAlphabetToUpperCase(val.Name), util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "boolean":
-						updateReqBody = updateReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueBool(),`, util.FirstAlphabetToUpperCase(val.Name), util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-					}
-				}
-			}
-
-			if targetResourceRequest.CRUDParameters.Update[0].RequestBody.Optional != nil {
-				for _, val := range targetResourceRequest.CRUDParameters.Update[0].RequestBody.Optional {
-
-					switch val.Type {
-					case "string":
-						updateOpOptionalParams = updateOpOptionalParams + fmt.Sprintf(`
-						if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-							reqParams.%[1]s = plan.%[1]s.ValueString()
-						}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "integer":
-						if val.Format != "" {
-							switch val.Format {
-							case "int64":
-								updateOpOptionalParams = updateOpOptionalParams + fmt.Sprintf(`
-								if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-									reqParams.%[1]s = plan.%[1]s.ValueInt64()
-								}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-							case "int32":
-								updateOpOptionalParams = updateOpOptionalParams + fmt.Sprintf(`
-								if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-									reqParams.%[1]s = plan.%[1]s.ValueInt32()
-								}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-							}
-						}
-
-					case "number":
-						updateOpOptionalParams = updateOpOptionalParams + fmt.Sprintf(`
-								if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-									reqParams.%[1]s = plan.%[1]s.ValueFloat64()
-								}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "boolean":
-						updateOpOptionalParams = updateOpOptionalParams + fmt.Sprintf(`
-						if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-							reqParams.%[1]s = plan.%[1]s.ValueBool()
-						}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					case "array":
-						updateOpOptionalParams = updateOpOptionalParams + fmt.Sprintf(`
-						if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-							reqParams.%[1]s = plan.%[1]s.ValueString()
-						}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-
-					// Array and Object are treated as string with serialization
-					default:
-						updateOpOptionalParams = updateOpOptionalParams + fmt.Sprintf(`
-						if !plan.%[1]s.IsNull() && !plan.%[1]s.IsUnknown() {
-							reqParams.%[1]s = plan.%[1]s.ValueString()
-						}`, util.FirstAlphabetToUpperCase(val.Name)) + "\n"
-					}
-				}
-			}
-
-		}
-
-		t.updateReqListParams = updateReqListParams
-		t.updateReqObjectParams = updateReqObjectParams
+	if len(crud.Update) > 0 {
 		t.isUpdateExists = true
-		t.updatePathParams = extractPathParams(targetResourceRequest.CRUDParameters.Update[0].Path)
-		t.updateMethod = targetResourceRequest.CRUDParameters.Update[0].Method
-		t.updateReqBody = updateReqBody
-		t.updateOpOptionalParams = updateOpOptionalParams
-		t.updateMethodName = strings.ToUpper(targetResourceRequest.CRUDParameters.Update[0].Method) + getMethodName(targetResourceRequest.CRUDParameters.Update[0].Path)
-	}
-
-	// Address Request > Delete
-	if targetResourceRequest.CRUDParameters.Delete != nil {
-		if targetResourceRequest.CRUDParameters.Delete.Parameters != nil {
-
-			if targetResourceRequest.CRUDParameters.Delete.Parameters.Required != nil {
-				for _, val := range targetResourceRequest.CRUDParameters.Delete.Parameters.Required {
-					switch val.Type {
-					case "string":
-						deleteReqBody = deleteReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueString(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-					case "integer":
-						if val.Format != "" {
-							switch val.Format {
-							case "int64":
-								deleteReqBody = deleteReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt64(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-							case "int32":
-								deleteReqBody = deleteReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueInt32(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-							}
-						}
-
-					case "number":
-						deleteReqBody = deleteReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueFloat64(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-
-					case "boolean":
-						deleteReqBody = deleteReqBody + fmt.Sprintf(`%[1]s: plan.%[2]s.ValueBool(),`, util.PathToPascal(val.Name), util.PathToPascal(val.Name)) + "\n"
-					}
-				}
-			}
-
-			if targetResourceRequest.CRUDParameters.Delete.Parameters.Optional != nil {
-				// NOTE - DELETE does not have optional parameters
-				panic("DELETE does not have optional Parameters in the previous cases. Please notify the developer to implement.")
-			}
-
-		}
-
-		if targetResourceRequest.CRUDParameters.Delete.RequestBody != nil {
-			// NOTE - DELETE does not have RequestBody
-			panic("DELETE does not have RequestBody in the previous cases. Please notify the developer to implement.")
-		}
-
-		t.deletePathParams = extractPathParams(targetResourceRequest.CRUDParameters.Delete.Path)
-		t.deleteMethod = targetResourceRequest.CRUDParameters.Delete.Method
-		t.deleteMethodName = strings.ToUpper(targetResourceRequest.CRUDParameters.Delete.Method) + getMethodName(targetResourceRequest.CRUDParameters.Delete.Path)
 	}
 
 	t.funcMap = funcMap
 	t.providerName = spec.Provider.Name
-	t.packageName = packageName
+	t.packageName = resourcePackageName(resourceName, packageName)
 	t.refreshObjectName = refreshObjectName
-	t.importStateLogic = makeImportStateLogic(importStateOverride)
-	t.model = model
-	t.refreshLogic = refreshLogic
+	t.importState = importState
+	t.createStep, t.updateStep = MakeTestSteps(attributes, operations.Create, operations.Update)
+	t.model = MakeResourceModel(attributes)
 	t.refreshWithResponse = MakeRefreshFromResponse(attributes, resourceName)
-	t.endpoint = spec.Provider.Endpoint
-	t.createReqBody = createReqBody
-	t.readReqBody = readReqBody
-	t.deleteReqBody = deleteReqBody
-	t.readReqBodyForCheckExist = readReqBodyForCheckExist
-	t.readReqBodyForCheckDestroy = readReqBodyForCheckDestroy
-	t.createOpOptionalParams = createOpOptionalParams
-	t.readOpOptionalParams = readOpOptionalParams
-	t.idGetter = makeIdGetter(id)
-
-	b = t
-
-	return b
+	t.endpoints = endpoints
+	t.operations = operations
+	t.id = id
+	t.idGetter = idGetter
+	t.wait = wait
+	t.waitConfig = targetResourceRequest.Wait
+	t.timeouts = timeouts
+
+	return t, nil
 }
 
+var nonIdentifierPattern = regexp.MustCompile(`[^0-9A-Za-z_]`)
+
 func getMethodName(s string) string {
 	parts := strings.Split(s, "/")
 	var result []string
@@ -1000,12 +569,8 @@ func getMethodName(s string) string {
 			continue
 		}
 
-		// Remove curly braces if present
-		part = strings.TrimPrefix(part, "{")
-		part = strings.TrimSuffix(part, "}")
-
-		// Remove hyphens and convert to uppercase
-		part = strings.ReplaceAll(part, "-", "")
+		// Remove curly braces, hyphens and other characters which are not allowed in identifiers, e.g. {file-name}.json, and convert to uppercase
+		part = nonIdentifierPattern.ReplaceAllString(part, "")
 		part = util.FirstAlphabetToUpperCase(part)
 
 		result = append(result, part)
@@ -1014,193 +579,35 @@ func getMethodName(s string) string {
 	return strings.Join(result, "")
 }
 
-func extractPathParams(path string) string {
-	parts := strings.Split(path, "/")
-	s := ``
-
-	for idx, val := range parts {
-
-		if len(val) < 1 {
-			continue
-		}
-
-		s = s + `+"/"+`
-
-		start := strings.Index(val, "{")
-
-		// if val doesn't wrapped with curly brace
-		if start == -1 {
-			s = s + fmt.Sprintf(`"%s"`, val)
-		} else {
-			if idx == len(parts)-1 {
-				s = s + `plan.ID.ValueString()`
-			} else {
-				s = s + fmt.Sprintf(`plan.%s.ValueString())`, util.PathToPascal(val))
-			}
-		}
-	}
-
-	return s
-}
-
-func extractReadPathParams(path string) string {
-	parts := strings.Split(path, "/")
-	s := ``
-
-	for idx, val := range parts {
-		if len(val) < 1 {
-			continue
-		}
-
-		if idx == len(parts)-1 {
-			continue
-		}
-
-		s = s + `+"/"+`
-
-		start := strings.Index(val, "{")
-
-		// if val doesn't wrapped with curly brace
-		if start == -1 {
-			s = s + fmt.Sprintf(`"%s"`, val)
-		} else {
-			s = s + fmt.Sprintf(`plan.%s.ValueString()`, util.PathToPascal(val))
-		}
+// makeIdGetter returns the expression reading the id from the create response, which evaluates to the id and an error.
+func makeIdGetter(target string) (string, error) {
+	p, err := ParseIDPath(target)
+	if err != nil {
+		return "", fmt.Errorf("id: %w", err)
 	}
 
-	return s
+	return fmt.Sprintf("ncloudsdk.StringAt(createRes, %s)", p.Args()), nil
 }
 
-func makeIdGetter(target string) string {
-	parts := strings.Split(target, ".")
-	s := "createRes"
+// MakeRefreshFromResponse generates the code filling postPlan from the object returned by the read operation.
+// Every attribute held by the model is converted into its schema type, a missing attribute becoming null.
+func MakeRefreshFromResponse(attrs resource.Attributes, resourceName string) string {
+	var s strings.Builder
 
-	for idx, val := range parts {
-		if idx == len(parts)-1 {
-			s = s + fmt.Sprintf(`["%s"].(string)`, util.ToCamelCase(val))
+	for _, val := range attrs {
+		valueType, expr, ok := resourceModelAttrType(val)
+		if !ok {
 			continue
 		}
 
-		s = s + fmt.Sprintf(`["%s"].(map[string]interface{})`, util.ToCamelCase(val))
-	}
-
-	return s
-}
-
-func makeImportStateLogic(target string) string {
-	parts := strings.Split(target, ".")
-
-	if len(parts) < 2 {
-		return `resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)` + "\n"
-	}
-
-	s := `parts := strings.Split(req.ID, ".")` + "\n"
-	for idx, val := range parts {
-		s = s + fmt.Sprintf(`resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("%s"), parts[%d])...)`, util.ToLowerCase(util.PathToPascal(val)), idx) + "\n"
+		s.WriteString(fmt.Sprintf(`
+	postPlan.%[3]s, err = ncloudsdk.Convert[%[4]s](ncloudsdk.AttributeAt(response.%[2]s, "%[1]s"), %[5]s)
+	if err != nil {
+		diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert %[1]s: %%v", err))
+		return false
 	}
-
-	return s
-}
-
-func MakeRefreshFromResponse(attr resource.Attributes, resourceName string) string {
-	var s strings.Builder
-
-	for _, val := range attr {
-		if val.String != nil {
-			s.WriteString(fmt.Sprintf(`
-			if !response.%[2]s.Attributes()["%[1]s"].IsNull() || !response.%[2]s.Attributes()["%[1]s"].IsUnknown() {
-				postPlan.%[3]s = types.StringValue(response.%[2]s.Attributes()["%[1]s"].String())
-			}`, val.Name, util.ToPascalCase(resourceName), util.ToPascalCase(val.Name)) + "\n")
-		} else if val.Bool != nil {
-			s.WriteString(fmt.Sprintf(`
-			if !response.%[2]s.Attributes()["%[1]s"].IsNull() || !response.%[2]s.Attributes()["%[1]s"].IsUnknown() {
-				tempVal, err := strconv.ParseBool(response.%[2]s.Attributes()["%[1]s"].String())
-				if err != nil {
-					diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert %[1]s to bool: %%v", err))
-					return
-				}
-				postPlan.%[3]s = types.BoolValue(tempVal)
-			}`, val.Name, util.ToPascalCase(resourceName), util.ToPascalCase(val.Name)) + "\n")
-		} else if val.Int32 != nil {
-			s.WriteString(fmt.Sprintf(`
-			if !response.%[2]s.Attributes()["%[1]s"].IsNull() || !response.%[2]s.Attributes()["%[1]s"].IsUnknown() {
-				tempVal, err := strconv.Atoi(response.%[2]s.Attributes()["%[1]s"].String())
-				if err != nil {
-					diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert %[1]s to int: %%v", err))
-					return
-				}
-				postPlan.%[3]s = types.Int32Value(int32(tempVal))
-			}`, val.Name, util.ToPascalCase(resourceName), util.ToPascalCase(val.Name)) + "\n")
-		} else if val.Int64 != nil {
-			s.WriteString(fmt.Sprintf(`
-			if !response.%[2]s.Attributes()["%[1]s"].IsNull() || !response.%[2]s.Attributes()["%[1]s"].IsUnknown() {
-				tempVal, err := strconv.Atoi(response.%[2]s.Attributes()["%[1]s"].String())
-				if err != nil {
-					diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert %[1]s to int: %%v", err))
-					return
-				}
-				postPlan.%[3]s = types.Int64Value(int64(tempVal))
-			}`, val.Name, util.ToPascalCase(resourceName), util.ToPascalCase(val.Name)) + "\n")
-		} else if val.Float64 != nil || val.Float32 != nil {
-			s.WriteString(fmt.Sprintf(`
-			if !response.%[2]s.Attributes()["%[1]s"].IsNull() || !response.%[2]s.Attributes()["%[1]s"].IsUnknown() {
-				tempVal, err := strconv.ParseFloat(response.%[2]s.Attributes()["%[1]s"].String(), 64)
-				if err != nil {
-					diagnostics.AddError("CONVERSION ERROR", fmt.Sprintf("Failed to convert %[1]s to float64: %%v", err))
-					return
-				}
-				postPlan.%[3]s = types.Float64Value(tempVal)
-			}`, val.Name, util.ToPascalCase(resourceName), util.ToPascalCase(val.Name)) + "\n")
-		} else if val.List != nil {
-			s.WriteString(fmt.Sprintf(`
-			if !response.%[2]s.Attributes()["%[1]s"].IsNull() || !response.%[2]s.Attributes()["%[1]s"].IsUnknown() {
-				listRes, diag := types.ListValueFrom(ctx, postPlan.%[2]s.AttributeTypes(ctx), response.%[2]s)
-				if diag.HasError() {
-					diagnostics.AddError("CONVERSION ERROR", "Error occured while getting object value: %[1]s")
-					return
-				}
-				postPlan.%[3]s = listRes
-			}`, val.Name, util.ToPascalCase(resourceName), util.ToPascalCase(val.Name)) + "\n")
-		} else if val.ListNested != nil {
-			s.WriteString(fmt.Sprintf(`
-			if !response.%[2]s.Attributes()["%[1]s"].IsNull() || !response.%[2]s.Attributes()["%[1]s"].IsUnknown() {
-				listRes, diag := types.ListValueFrom(ctx, postPlan.%[2]s.AttributeTypes(ctx), response.%[2]s)
-				if diag.HasError() {
-					diagnostics.AddError("CONVERSION ERROR", "Error occured while getting object value: %[1]s")
-					return
-				}
-				postPlan.%[3]s = listRes
-			}`, val.Name, util.ToPascalCase(resourceName), util.ToPascalCase(val.Name)) + "\n")
-		} else if val.SingleNested != nil {
-			s.WriteString(fmt.Sprintf(`
-			if !response.%[2]s.Attributes()["%[1]s"].IsNull() || !response.%[2]s.Attributes()["%[1]s"].IsUnknown() {
-				objectRes, diag := types.ObjectValueFrom(ctx, postPlan.%[2]s.AttributeTypes(ctx), response.%[2]s)
-				if diag.HasError() {
-					diagnostics.AddError("CONVERSION ERROR", "Error occured while getting object value: %[1]s")
-					return
-				}
-				postPlan.%[3]s = objectRes
-			}`, val.Name, util.ToPascalCase(resourceName), util.ToPascalCase(val.Name)) + "\n")
-		}
+`, PascalToSnakeCase(val.Name), util.ToPascalCase(resourceName), util.ToPascalCase(val.Name), valueType, expr))
 	}
 
 	return s.String()
 }
-
-func MakeTestTFConfig(c *util.NcloudCommonRequestType) string {
-	var t strings.Builder
-
-	if c.RequestBody != nil {
-		for _, val := range c.RequestBody.Required {
-			t.WriteString(fmt.Sprintf(`		%[1]s = "%[2]s"`, PascalToSnakeCase(val.Name), "tf-"+acctest.RandString(5)) + "\n")
-		}
-	}
-
-	if c.Parameters != nil {
-		for _, val := range c.Parameters.Required {
-			t.WriteString(fmt.Sprintf(`		%[1]s = "%[2]s"`, PascalToSnakeCase(val.Name), "tf-"+acctest.RandString(5)) + "\n")
-		}
-	}
-
-	return t.String()
-}
@@ -0,0 +1,232 @@
+package ncloud
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+)
+
+// SDKResponseField is an object of the read response, exposed to the refresh logic of a resource or data source.
+type SDKResponseField struct {
+	// FieldName is the name of the field in the generated response struct, e.g. response.Product.
+	FieldName string
+
+	// Key is the key of the object within the response body.
+	Key string
+}
+
+// SDKOperation is a single client method of the generated SDK package.
+type SDKOperation struct {
+	*Operation
+	HTTPMethod     string
+	ResponseFields []*SDKResponseField
+}
+
+// NeedsTypes reports whether the generated file refers to the framework types package.
+func (o *SDKOperation) NeedsTypes() bool {
+	if len(o.ResponseFields) > 0 {
+		return true
+	}
+
+	for _, f := range o.Fields {
+		if !f.IsScalar() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FileName returns the name of the file holding the operation, e.g. get_products_productid.go.
+func (o *SDKOperation) FileName() string {
+	return fmt.Sprintf("%s_%s.go", strings.ToLower(o.HTTPMethod), util.ToSnakeCase(getMethodName(o.Path)))
+}
+
+// SDK holds every operation declared within crud_parameters, deduplicated by method name.
+type SDK struct {
+	packageName string
+	operations  map[string]*SDKOperation
+	funcMap     template.FuncMap
+}
+
+func NewSDK(spec util.NcloudSpecification, packageName string) (*SDK, error) {
+	s := &SDK{
+		packageName: packageName,
+		operations:  make(map[string]*SDKOperation),
+		funcMap:     util.CreateFuncMap(),
+	}
+
+	for _, r := range spec.Resources {
+		ops, err := NewCrudOperations(r.CRUDParameters)
+		if err != nil {
+			return nil, fmt.Errorf("resource %s: %w", r.Name, err)
+		}
+
+		s.add(ops.Create)
+		s.add(ops.Read).addResponse(r.Name)
+		for _, op := range ops.Update {
+			s.add(op)
+		}
+		s.add(ops.Delete)
+	}
+
+	for _, d := range spec.DataSources {
+		op, err := NewOperation(d.CRUDParameters.Read)
+		if err != nil {
+			return nil, fmt.Errorf("data source %s: read: %w", d.Name, err)
+		}
+
+		s.add(op).addResponse(d.Name)
+	}
+
+	return s, nil
+}
+
+// add registers the operation unless an operation with the same method name already exists.
+// Empty operations are ignored and nil is returned.
+func (s *SDK) add(op *Operation) *SDKOperation {
+	if op == nil || op.MethodName == "" {
+		return nil
+	}
+
+	if existing, ok := s.operations[op.MethodName]; ok {
+		return existing
+	}
+
+	o := &SDKOperation{
+		Operation:  op,
+		HTTPMethod: strings.ToUpper(op.Method),
+	}
+	s.operations[op.MethodName] = o
+
+	return o
+}
+
+// addResponse exposes the object of the read response, which APIs wrap under the name of the resource, e.g. {"product": {...}}.
+// refresh_object_name names a schema object, not a key of the response, so it is not used here.
+func (o *SDKOperation) addResponse(name string) {
+	if o == nil {
+		return
+	}
+
+	field := &SDKResponseField{
+		FieldName: util.ToPascalCase(name),
+		Key:       util.ToCamelCase(name),
+	}
+
+	for _, f := range o.ResponseFields {
+		if f.FieldName == field.FieldName {
+			return
+		}
+	}
+
+	o.ResponseFields = append(o.ResponseFields, field)
+}
+
+// Operations returns the operations sorted by method name, so generated output is stable.
+func (s *SDK) Operations() []*SDKOperation {
+	ops := make([]*SDKOperation, 0, len(s.operations))
+	for _, op := range s.operations {
+		ops = append(ops, op)
+	}
+
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].MethodName < ops[j].MethodName
+	})
+
+	return ops
+}
+
+func (s *SDK) RenderClient() ([]byte, error) {
+	var b bytes.Buffer
+
+	clientTemplate, err := template.New("").Funcs(s.funcMap).Parse(SDKClientTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	data := struct {
+		PackageName string
+	}{
+		PackageName: s.packageName,
+	}
+
+	err = clientTemplate.ExecuteTemplate(&b, "SDK_Client", data)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func (s *SDK) RenderConvert() ([]byte, error) {
+	var b bytes.Buffer
+
+	convertTemplate, err := template.New("").Funcs(s.funcMap).Parse(SDKConvertTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	data := struct {
+		PackageName string
+	}{
+		PackageName: s.packageName,
+	}
+
+	err = convertTemplate.ExecuteTemplate(&b, "SDK_Convert", data)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func (s *SDK) RenderFactory() ([]byte, error) {
+	var b bytes.Buffer
+
+	factoryTemplate, err := template.New("").Funcs(s.funcMap).Parse(SDKFactoryTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	data := struct {
+		PackageName string
+	}{
+		PackageName: s.packageName,
+	}
+
+	err = factoryTemplate.ExecuteTemplate(&b, "SDK_Factory", data)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func (s *SDK) RenderOperation(op *SDKOperation) ([]byte, error) {
+	var b bytes.Buffer
+
+	operationTemplate, err := template.New("").Funcs(s.funcMap).Parse(SDKOperationTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	data := struct {
+		PackageName string
+		Operation   *SDKOperation
+	}{
+		PackageName: s.packageName,
+		Operation:   op,
+	}
+
+	err = operationTemplate.ExecuteTemplate(&b, "SDK_Operation", data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op.MethodName, err)
+	}
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,61 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewSDK(t *testing.T) {
+	t.Parallel()
+
+	read := &util.NcloudCommonRequestType{Method: "get", Path: "/products/{product-id}"}
+
+	spec := util.NcloudSpecification{
+		Resources: []util.Resource{
+			{
+				Resource: resource.Resource{Name: "product"},
+				CRUDParameters: util.CrudParameters{
+					Create: &util.NcloudCommonRequestType{Method: "POST", Path: "/products"},
+					Read:   read,
+					Delete: &util.NcloudCommonRequestType{Method: "DELETE", Path: "/products/{product-id}"},
+				},
+				RefreshObjectName: "PostProductResponse",
+			},
+		},
+		DataSources: []util.DataSource{
+			{
+				DataSource:     datasource.DataSource{Name: "product_detail"},
+				CRUDParameters: util.CrudParameters{Read: read},
+			},
+		},
+	}
+
+	sdk, err := NewSDK(spec, "ncloudsdk")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, op := range sdk.Operations() {
+		got = append(got, op.FileName())
+	}
+
+	expected := []string{"delete_products_productid.go", "get_products_productid.go", "post_products.go"}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	expectedResponse := []*SDKResponseField{
+		{FieldName: "Product", Key: "product"},
+		{FieldName: "ProductDetail", Key: "productDetail"},
+	}
+
+	if diff := cmp.Diff(sdk.operations["GETProductsProductid"].ResponseFields, expectedResponse); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
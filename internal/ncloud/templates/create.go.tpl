@@ -1,20 +1,16 @@
 {{ define "Create" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Create Template
  * Required data are as follows
  *
-		ResourceName           string
-		RefreshObjectName      string
-		CreateReqBody          string
-		CreateReqListParam     string
-		CreateReqObjectParam   string
-		CreateReqOptionalParam string
-		CreateMethod           string
-		CreateMethodName       string
-		Endpoint               string
-		CreatePathParams       string
-		IdGetter               string
- * ================================================================================= */
+		ResourceName      string
+		RefreshObjectName string
+		CreateOp          *Operation
+		CreateMethod      string
+		CreateMethodName  string
+		IdGetter          string
+		Timeouts          *Timeouts
+ * ================================================================================= */}}
 
 func (a *{{.ResourceName | ToCamelCase}}Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan {{.RefreshObjectName | ToPascalCase}}Model
@@ -23,22 +19,29 @@ func (a *{{.ResourceName | ToCamelCase}}Resource) Create(ctx context.Context, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	{{- if .Timeouts }}
 
-	c := ncloudsdk.NewClient("{{.Endpoint}}", os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"))
+	createTimeout, diags := plan.Timeouts.Create(ctx, {{.Timeouts.Create}})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	{{- end }}
 
 	reqParams := &ncloudsdk.Primitive{{.CreateMethodName}}Request{
-		{{.CreateReqBody}}
+		{{- template "RequestRequiredFields" .CreateOp }}
 	}
 
-	{{.CreateReqListParam}}
-
-	{{.CreateReqObjectParam}}
+	{{- template "RequestCollectionFields" .CreateOp }}
 
-	{{.CreateReqOptionalParam}}
+	{{- template "RequestOptionalFields" .CreateOp }}
 
 	tflog.Info(ctx, "Create{{.ResourceName | ToPascalCase}} reqParams="+common.MarshalUncheckedString(reqParams))
 
-	response, err := c.{{.CreateMethodName}}(ctx, reqParams)
+	response, err := a.client.{{.CreateMethodName}}_TF(ctx, reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Error with {{.CreateMethodName}}_TF", err.Error())
 		return
@@ -46,7 +49,7 @@ func (a *{{.ResourceName | ToCamelCase}}Resource) Create(ctx context.Context, re
 
 	tflog.Info(ctx, "Create{{.ResourceName | ToPascalCase}} response="+common.MarshalUncheckedString(response))
 
-	plan.refreshFromOutput_createOp(ctx, &resp.Diagnostics, response)
+	plan.refreshFromOutput_createOp(ctx, a.client, &resp.Diagnostics, response)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -1,17 +1,16 @@
 {{ define "Delete" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Delete Template
  * Required data are as follows
  *
 		ResourceName      string
 		RefreshObjectName string
+		DeleteOp          *Operation
 		DeleteMethod      string
-		DeleteReqBody     string
 		DeleteMethodName  string
-		Endpoint          string
-		DeletePathParams  string
 		IdGetter          string
- * ================================================================================= */
+		Timeouts          *Timeouts
+ * ================================================================================= */}}
 
 func (a *{{.ResourceName | ToCamelCase}}Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var plan {{.RefreshObjectName | ToPascalCase}}Model
@@ -20,22 +19,35 @@ func (a *{{.ResourceName | ToCamelCase}}Resource) Delete(ctx context.Context, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	{{- if .Timeouts }}
 
- 	reqParams := &ncloudsdk.Primitive{{.DeleteMethodName}}Request{
-		{{.DeleteReqBody}}
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, {{.Timeouts.Delete}})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	{{- end }}
+
+	reqParams := &ncloudsdk.Primitive{{.DeleteMethodName}}Request{
+		{{- template "RequestRequiredFields" .DeleteOp }}
 	}
 
-	tflog.Info(ctx, "Update{{.DeleteMethodName}} reqParams="+common.MarshalUncheckedString(reqParams))
+	{{- template "RequestCollectionFields" .DeleteOp }}
+
+	{{- template "RequestOptionalFields" .DeleteOp }}
 
-	c := ncloudsdk.NewClient("{{.Endpoint}}", os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"))
+	tflog.Info(ctx, "Delete{{.DeleteMethodName}} reqParams="+common.MarshalUncheckedString(reqParams))
 
-	_, err := c.{{.DeleteMethodName}}_TF(ctx, reqParams)
+	_, err := a.client.{{.DeleteMethodName}}_TF(ctx, reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
 	}
 
-	err = plan.waitResourceDeleted(ctx, plan.ID.ValueString())
+	err = plan.waitResourceDeleted(ctx, a.client, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
 		return
@@ -1,14 +1,49 @@
 {{ define "ImportState" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Import Template
  * Required data are as follows
  *
- 		ResourceName     string
-		ImportStateLogic string
- * ================================================================================= */
+ 		ResourceName string
+		ImportState  *ImportState
+ * ================================================================================= */}}
 
 func (a *{{.ResourceName | ToCamelCase}}Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	{{.ImportStateLogic}}
+	{{- if .ImportState }}
+	parts := strings.Split(req.ID, {{ printf "%q" .ImportState.Separator }})
+
+	isValid := len(parts) == {{ len .ImportState.Parts }}
+	for _, part := range parts {
+		isValid = isValid && part != ""
+	}
+
+	if !isValid {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: {{.ImportState.Format}}. Got: %q", req.ID),
+		)
+		return
+	}
+	{{- range $idx, $part := .ImportState.Parts }}
+	{{- if eq .Type "int64" }}
+
+	part{{ $idx }}, err := strconv.ParseInt(parts[{{ $idx }}], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected <{{.Attribute}}> of the import identifier to be an integer. Got: %q", parts[{{ $idx }}]),
+		)
+		return
+	}
+	{{- end }}
+	{{- end }}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	{{- range $idx, $part := .ImportState.Parts }}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("{{.Attribute}}"), {{ if eq .Type "int64" }}part{{ $idx }}{{ else }}parts[{{ $idx }}]{{ end }})...)
+	{{- end }}
+	{{- else }}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	{{- end }}
 }
 
-{{ end }}
\ No newline at end of file
+{{ end }}
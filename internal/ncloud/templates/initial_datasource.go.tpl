@@ -1,11 +1,12 @@
 {{ define "Initial_DataSource" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Initial Template
  * Required data are as follows
  *
 		ProviderName   string
 		DataSourceName string
- * ================================================================================= */
+		Endpoints      string
+ * ================================================================================= */}}
 
 var (
 	_ datasource.DataSource              = &{{.DataSourceName | ToCamelCase}}DataSource{}
@@ -17,7 +18,7 @@ func New{{.DataSourceName | ToPascalCase}}DataSource() datasource.DataSource {
 }
 
 type {{.DataSourceName | ToCamelCase}}DataSource struct {
-	config *conn.ProviderConfig
+	client *ncloudsdk.Client
 }
 
 func (b *{{.DataSourceName | ToCamelCase}}DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -25,17 +26,17 @@ func (b *{{.DataSourceName | ToCamelCase}}DataSource) Configure(_ context.Contex
 		return
 	}
 
-	config, ok := req.ProviderData.(*conn.ProviderConfig)
+	factory, ok := req.ProviderData.(ncloudsdk.ClientFactory)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected ncloudsdk.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	b.config = config
+	b.client = factory.NewClient({{.Endpoints}})
 }
 
 func (b *{{.DataSourceName | ToCamelCase}}DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
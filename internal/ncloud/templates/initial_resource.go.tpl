@@ -1,11 +1,13 @@
 {{ define "Initial" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Initial Template
  * Required data are as follows
  *
 		ProviderName string
 		ResourceName string
- * ================================================================================= */
+		Endpoints    string
+		Timeouts     *Timeouts
+ * ================================================================================= */}}
 
 var (
 	_ resource.Resource                = &{{.ResourceName | ToCamelCase}}Resource{}
@@ -18,7 +20,7 @@ func New{{.ResourceName | ToPascalCase}}Resource() resource.Resource {
 }
 
 type {{.ResourceName | ToCamelCase}}Resource struct {
-	config *conn.ProviderConfig
+	client *ncloudsdk.Client
 }
 
 func (a *{{.ResourceName | ToCamelCase}}Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -26,17 +28,17 @@ func (a *{{.ResourceName | ToCamelCase}}Resource) Configure(_ context.Context, r
 		return
 	}
 
-	config, ok := req.ProviderData.(*conn.ProviderConfig)
+	factory, ok := req.ProviderData.(ncloudsdk.ClientFactory)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected ncloudsdk.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	a.config = config
+	a.client = factory.NewClient({{.Endpoints}})
 }
 
 func (a *{{.ResourceName | ToCamelCase}}Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -45,6 +47,18 @@ func (a *{{.ResourceName | ToCamelCase}}Resource) Metadata(ctx context.Context,
 
 func (a *{{.ResourceName | ToCamelCase}}Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = {{.ResourceName | ToPascalCase}}ResourceSchema(ctx)
+	{{- if .Timeouts }}
+
+	if resp.Schema.Blocks == nil {
+		resp.Schema.Blocks = make(map[string]schema.Block)
+	}
+
+	resp.Schema.Blocks["timeouts"] = timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Update: true,
+		Delete: true,
+	})
+	{{- end }}
 }
 
 {{ end }}
\ No newline at end of file
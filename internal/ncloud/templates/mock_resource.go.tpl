@@ -0,0 +1,331 @@
+{{ define "Mock" }}
+{{- /* =================================================================================
+ * Mock Template
+ * Required data are as follows
+ *
+		ProviderName   string
+		ResourceName   string
+		PackageName    string
+		Mock           *Mock
+		CreateStep     *TestStep
+		UpdateStep     *TestStep
+		ImportState    *ImportState
+		IgnoreTimeouts bool
+ * ================================================================================= */}}
+
+package {{.PackageName}}
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+{{- if .ImportState }}
+	"strings"
+{{- end }}
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestUnit{{.ResourceName | ToPascalCase}}Resource_basic drives the resource through the plugin framework against an in-memory API server,
+// so it runs without credentials.
+func TestUnit{{.ResourceName | ToPascalCase}}Resource_basic(t *testing.T) {
+	server := newMock{{.ResourceName | ToPascalCase}}Server(t)
+	suffix := acctest.RandString(5)
+
+	resourceName := "ncloud_{{.ProviderName | ToLowerCase}}_{{.ResourceName | ToLowerCase}}.testing_{{.ResourceName | ToLowerCase}}"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"ncloud": providerserver.NewProtocol6WithError(&mock{{.ResourceName | ToPascalCase}}Provider{url: server.URL}),
+		},
+		CheckDestroy: server.checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnit{{.ResourceName | ToLowerCase}}Config(suffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					{{- range .CreateStep.Checks }}
+					resource.TestCheckResourceAttr(resourceName, "{{.Key}}", {{.Value}}),
+					{{- end }}
+				),
+			},
+			{{- if .UpdateStep }}
+			{
+				Config: testUnit{{.ResourceName | ToLowerCase}}ConfigUpdate(suffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					{{- range .UpdateStep.Checks }}
+					resource.TestCheckResourceAttr(resourceName, "{{.Key}}", {{.Value}}),
+					{{- end }}
+				),
+			},
+			{{- end }}
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				{{- if .ImportState }}
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found %s", resourceName)
+					}
+
+					return strings.Join([]string{
+						{{- range .ImportState.Parts }}
+						rs.Primary.Attributes["{{.Attribute}}"],
+						{{- end }}
+					}, {{ printf "%q" .ImportState.Separator }}), nil
+				},
+				{{- end }}
+				{{- if .IgnoreTimeouts }}
+				ImportStateVerifyIgnore: []string{"timeouts"},
+				{{- end }}
+			},
+		},
+	})
+}
+
+func testUnit{{.ResourceName | ToLowerCase}}Config(suffix string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_{{.ProviderName | ToLowerCase}}_{{.ResourceName | ToLowerCase}}" "testing_{{.ResourceName | ToLowerCase}}" {
+{{.CreateStep.Config -}}
+	}`, suffix)
+}
+{{- if .UpdateStep }}
+
+func testUnit{{.ResourceName | ToLowerCase}}ConfigUpdate(suffix string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_{{.ProviderName | ToLowerCase}}_{{.ResourceName | ToLowerCase}}" "testing_{{.ResourceName | ToLowerCase}}" {
+{{.UpdateStep.Config -}}
+	}`, suffix)
+}
+{{- end }}
+
+// mock{{.ResourceName | ToPascalCase}}Provider serves the resource with clients calling the mock API server.
+type mock{{.ResourceName | ToPascalCase}}Provider struct {
+	url string
+}
+
+var _ provider.Provider = &mock{{.ResourceName | ToPascalCase}}Provider{}
+
+func (p *mock{{.ResourceName | ToPascalCase}}Provider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "ncloud"
+}
+
+func (p *mock{{.ResourceName | ToPascalCase}}Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = providerschema.Schema{}
+}
+
+func (p *mock{{.ResourceName | ToPascalCase}}Provider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	factory := &mock{{.ResourceName | ToPascalCase}}Factory{url: p.url}
+
+	resp.ResourceData = factory
+	resp.DataSourceData = factory
+}
+
+func (p *mock{{.ResourceName | ToPascalCase}}Provider) Resources(_ context.Context) []func() fwresource.Resource {
+	return []func() fwresource.Resource{
+		New{{.ResourceName | ToPascalCase}}Resource,
+	}
+}
+
+func (p *mock{{.ResourceName | ToPascalCase}}Provider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// mock{{.ResourceName | ToPascalCase}}Factory returns clients calling the mock API server, whatever the endpoint.
+type mock{{.ResourceName | ToPascalCase}}Factory struct {
+	url string
+}
+
+var _ ncloudsdk.ClientFactory = &mock{{.ResourceName | ToPascalCase}}Factory{}
+
+func (f *mock{{.ResourceName | ToPascalCase}}Factory) NewClient(_ ncloudsdk.Endpoints) *ncloudsdk.Client {
+	return ncloudsdk.NewClient(f.url, "access-key", "secret-key")
+}
+
+var mock{{.ResourceName | ToPascalCase}}Routes = []struct {
+	method  string
+	pattern *regexp.Regexp
+	kind    string
+}{
+	{{- range .Mock.Routes }}
+	{"{{.Method}}", regexp.MustCompile(`{{.Pattern}}`), "{{.Kind}}"},
+	{{- end }}
+}
+
+// mock{{.ResourceName | ToPascalCase}}Server is an in-memory fake of the API, serving the paths of crud_parameters.
+// Objects hold the fields of the requests, and are identified by the last path parameter.
+type mock{{.ResourceName | ToPascalCase}}Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	objects map[string]map[string]interface{}
+	nextID  int
+}
+
+func newMock{{.ResourceName | ToPascalCase}}Server(t *testing.T) *mock{{.ResourceName | ToPascalCase}}Server {
+	t.Helper()
+
+	m := &mock{{.ResourceName | ToPascalCase}}Server{
+		objects: make(map[string]map[string]interface{}),
+	}
+	m.Server = httptest.NewServer(m)
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+func (m *mock{{.ResourceName | ToPascalCase}}Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fields := map[string]interface{}{}
+
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Numbers are kept as sent, so integers beyond the precision of float64 are echoed back unchanged.
+	if len(b) > 0 {
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.UseNumber()
+
+		if err := d.Decode(&fields); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	for k, v := range r.URL.Query() {
+		fields[k] = v[0]
+	}
+
+	for _, route := range mock{{.ResourceName | ToPascalCase}}Routes {
+		if route.method != r.Method {
+			continue
+		}
+
+		match := route.pattern.FindStringSubmatch(r.URL.Path)
+		if match == nil {
+			continue
+		}
+
+		id := match[len(match)-1]
+
+		switch route.kind {
+		case "create":
+			m.nextID++
+			id = strconv.Itoa(m.nextID)
+
+			fields["{{.Mock.IDKey}}"] = id
+			{{- if .Mock.CreateStatus }}
+			fields["{{.Mock.StatusKey}}"] = "{{.Mock.CreateStatus}}"
+			{{- end }}
+			m.objects[id] = fields
+
+			m.write(w, fields)
+		case "read":
+			obj, ok := m.objects[id]
+			if !ok {
+				m.notFound(w)
+				return
+			}
+
+			m.write(w, obj)
+		case "update":
+			obj, ok := m.objects[id]
+			if !ok {
+				m.notFound(w)
+				return
+			}
+
+			// Fields sent as null clear their value.
+			for k, v := range fields {
+				if v == nil {
+					delete(obj, k)
+					continue
+				}
+				obj[k] = v
+			}
+			{{- if .Mock.UpdateStatus }}
+			obj["{{.Mock.StatusKey}}"] = "{{.Mock.UpdateStatus}}"
+			{{- end }}
+
+			m.write(w, obj)
+		case "delete":
+			if _, ok := m.objects[id]; !ok {
+				m.notFound(w)
+				return
+			}
+
+			delete(m.objects, id)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte("{}"))
+		}
+
+		return
+	}
+
+	http.NotFound(w, r)
+}
+
+// write responds with the object, nested the way the create response holds the id.
+func (m *mock{{.ResourceName | ToPascalCase}}Server) write(w http.ResponseWriter, obj map[string]interface{}) {
+	var body interface{} = obj
+
+	wrapper := []interface{}{ {{- .Mock.Wrapper.Args -}} }
+	for i := len(wrapper) - 1; i >= 0; i-- {
+		switch step := wrapper[i].(type) {
+		case string:
+			body = map[string]interface{}{step: body}
+		case int:
+			list := make([]interface{}, step+1)
+			list[step] = body
+			body = list
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// notFound responds the way the read operation reports a missing object.
+func (m *mock{{.ResourceName | ToPascalCase}}Server) notFound(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader({{.Mock.NotFoundStatus}})
+	{{- if .Mock.NotFoundBody }}
+	_, _ = w.Write([]byte(`{{.Mock.NotFoundBody}}`))
+	{{- end }}
+}
+
+func (m *mock{{.ResourceName | ToPascalCase}}Server) checkDestroy(_ *terraform.State) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.objects) > 0 {
+		return fmt.Errorf("{{.ResourceName}}: %d objects still exist", len(m.objects))
+	}
+
+	return nil
+}
+
+{{ end }}
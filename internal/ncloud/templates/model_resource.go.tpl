@@ -1,14 +1,18 @@
 {{ define "Model" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Model Template
  * Required data are as follows
  *
 		RefreshObjectName string
 		Model             string
- * ================================================================================= */
+		Timeouts          *Timeouts
+ * ================================================================================= */}}
 
 type {{.RefreshObjectName | ToPascalCase}}Model struct {
     ID types.String `tfsdk:"id"`
+    {{- if .Timeouts }}
+    Timeouts timeouts.Value `tfsdk:"timeouts"`
+    {{- end }}
     {{.Model}}
 }
 
@@ -1,11 +1,11 @@
 {{ define "Read_DataSource" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Read Template
  * Required data are as follows
  *
 		DataSourceName    string
 		RefreshObjectName string
- * ================================================================================= */
+ * ================================================================================= */}}
 
 func (a *{{.DataSourceName | ToCamelCase}}DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var plan {{.RefreshObjectName | ToPascalCase}}Model
@@ -15,7 +15,7 @@ func (a *{{.DataSourceName | ToCamelCase}}DataSource) Read(ctx context.Context,
 		return
 	}
 
-	plan.refreshFromOutput(ctx, &resp.Diagnostics)
+	plan.refreshFromOutput(ctx, a.client, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
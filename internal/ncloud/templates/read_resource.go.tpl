@@ -1,11 +1,11 @@
 {{ define "Read" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Read Template
  * Required data are as follows
  *
 		ResourceName      string
 		RefreshObjectName string
- * ================================================================================= */
+ * ================================================================================= */}}
 
 func (a *{{.ResourceName | ToCamelCase}}Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var plan {{.RefreshObjectName | ToPascalCase}}Model
@@ -15,7 +15,16 @@ func (a *{{.ResourceName | ToCamelCase}}Resource) Read(ctx context.Context, req
 		return
 	}
 
-	plan.refreshFromOutput(ctx, &resp.Diagnostics, plan.ID.ValueString())
+	exists := plan.refreshFromOutput(ctx, a.client, &resp.Diagnostics, plan.ID.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !exists {
+		tflog.Warn(ctx, "{{.ResourceName | ToPascalCase}} not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
@@ -1,58 +1,53 @@
 {{ define "Refresh_DataSource" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Refresh Template
  * Required data are as follows
  *
-		PackageName          string
-		ResourceName         string
-		RefreshObjectName    string
-		RefreshLogic         string
-		ReadMethodName       string
-		ReadReqBody          string
-		Endpoint             string
-		ReadPathParams       string
-		ReadOpOptionalParams string
-		IdGetter             string
- * ================================================================================= */
+		PackageName       string
+		ResourceName      string
+		RefreshObjectName string
+		RefreshLogic      string
+		NeedsAttr         bool
+		ReadOp            *Operation
+		ReadMethodName    string
+		IdGetter          string
+ * ================================================================================= */}}
 
 package {{.PackageName}}
 
 import (
 	"context"
 	"fmt"
-	"strings"
-	"unicode"
-
-	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+{{ if .NeedsAttr }}
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+{{- end }}
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func (plan *{{.RefreshObjectName | ToPascalCase}}Model) refreshFromOutput(ctx context.Context, diagnostics *diag.Diagnostics) {
-
-	c := ncloudsdk.NewClient("{{.Endpoint}}", os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"))
+func (plan *{{.RefreshObjectName | ToPascalCase}}Model) refreshFromOutput(ctx context.Context, c *ncloudsdk.Client, diagnostics *diag.Diagnostics) {
 
-	r := &ncloudsdk.{{.ReadMethodName}}Request{
-		{{.ReadReqBody}}
+	reqParams := &ncloudsdk.Primitive{{.ReadMethodName}}Request{
+		{{- template "RequestRequiredFields" .ReadOp }}
 	}
 
-	{{.ReadOpOptionalParams}}
+	{{- template "RequestOptionalFields" .ReadOp }}
 
-	response, err := c.{{.ReadMethodName}}_TF(r)
+	response, err := c.{{.ReadMethodName}}_TF(ctx, reqParams)
 
 	if err != nil {
-		 diagnostics.AddError("CREATING ERROR", err.Error())
+		 diagnostics.AddError("READING ERROR", err.Error())
 		 return
 	}
 
-	id := {{.IdGetter}}
-
 	var postPlan {{.RefreshObjectName | ToPascalCase}}Model
 
-	// Fill required attributes
+	// Fill attributes from the read response
+	{{.RefreshLogic}}
+
+	postPlan.ID = types.StringValue({{.IdGetter}})
+
 	*plan = postPlan
 }
 
-
-{{ end }}
\ No newline at end of file
+{{ end }}
@@ -1,80 +1,94 @@
 {{ define "Refresh" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Refresh Template
  * Required data are as follows
  *
-		PackageName       string
-		RefreshObjectName string
-		Endpoint          string
-		CreateMethodName  string
-		ReadMethodName    string
-		ReadReqBody       string
-		IdGetter          string
+		PackageName         string
+		RefreshObjectName   string
 		RefreshWithResponse string
- * ================================================================================= */
+		CreateMethodName    string
+		ReadOp              *Operation
+		ReadMethodName      string
+		IdGetter            string
+		Timeouts            *Timeouts
+ * ================================================================================= */}}
 
 package {{.PackageName}}
 
 import (
 	"context"
-	"os"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/terraform-providers/terraform-provider-ncloud/internal/ncloudsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 )
 
 // Diagnostics might not be Required.
 // Because response type of create operation is different from read operation, reload the read response to get unified refresh data.
-func (plan *{{.RefreshObjectName | ToPascalCase}}Model) refreshFromOutput_createOp(ctx context.Context, diagnostics *diag.Diagnostics, createRes map[string]interface{}) {
+func (plan *{{.RefreshObjectName | ToPascalCase}}Model) refreshFromOutput_createOp(ctx context.Context, c *ncloudsdk.Client, diagnostics *diag.Diagnostics, createRes map[string]interface{}) {
 
 	// Allocate resource id from create response
-	id := {{.IdGetter}}
-
-	// Indicate where to get resource id from create response
-	err := plan.waitResourceCreated(ctx, id)
-
+	id, err := {{.IdGetter}}
 	if err != nil {
-		diagnostics.AddError("CREATING ERROR", err.Error())
+		diagnostics.AddError("CREATING ERROR", fmt.Sprintf("reading id from the create response: %s", err))
 		return
 	}
 
-	var postPlan {{.RefreshObjectName | ToPascalCase}}Model
+	// Path parameters holding the resource identifier are read from plan.ID
+	plan.ID = types.StringValue(id)
 
-	c := ncloudsdk.NewClient("{{.Endpoint}}", os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"))
-	response, err := c.{{.ReadMethodName}}_TF(ctx, &ncloudsdk.Primitive{{.ReadMethodName}}Request{
-			{{.ReadReqBody}}
-	})
+	// Indicate where to get resource id from create response
+	err = plan.waitResourceCreated(ctx, c, id)
 
 	if err != nil {
 		diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
 
-	// Fill required attributes
-	{{.RefreshWithResponse}}
+	exists := plan.refreshFromOutput(ctx, c, diagnostics, id)
+	if diagnostics.HasError() {
+		return
+	}
 
-	*plan = postPlan
+	if !exists {
+		diagnostics.AddError("CREATING ERROR", "{{.ReadMethodName}}: resource was not found after creation")
+	}
 }
 
-func (plan *{{.RefreshObjectName | ToPascalCase}}Model) refreshFromOutput(ctx context.Context, diagnostics *diag.Diagnostics, id string) {
+// refreshFromOutput fills the plan from the read response.
+// It returns false without diagnostics when the resource does not exist anymore.
+func (plan *{{.RefreshObjectName | ToPascalCase}}Model) refreshFromOutput(ctx context.Context, c *ncloudsdk.Client, diagnostics *diag.Diagnostics, id string) bool {
 
-	c := ncloudsdk.NewClient("{{.Endpoint}}", os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"))
 	response, err := c.{{.ReadMethodName}}_TF(ctx, &ncloudsdk.Primitive{{.ReadMethodName}}Request{
-			{{.ReadReqBody}}
+		{{- template "RequestRequiredFields" .ReadOp }}
 	})
 
+	if ncloudsdk.IsNotFound(err) {
+		return false
+	}
+
 	if err != nil {
-		 diagnostics.AddError("CREATING ERROR", err.Error())
-		 return
+		diagnostics.AddError("READING ERROR", fmt.Sprintf("{{.ReadMethodName}}: %s", err))
+		return false
 	}
 
 	var postPlan {{.RefreshObjectName | ToPascalCase}}Model
 
+	postPlan.ID = types.StringValue(id)
+
 	// Fill required attributes
 	{{.RefreshWithResponse}}
+	{{- if .Timeouts }}
+
+	postPlan.Timeouts = plan.Timeouts
+	{{- end }}
 
 	*plan = postPlan
+
+	return true
 }
 
 {{ end }}
\ No newline at end of file
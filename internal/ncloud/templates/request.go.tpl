@@ -0,0 +1,87 @@
+{{/* =================================================================================
+ * Request Templates
+ * Rendered with *Operation. Generated code expects the model to be stored in "plan"
+ * and the request in "reqParams". Partial updates also expect the prior model in "state",
+ * and send optional body fields changed to null through reqParams.NullFields.
+ * ================================================================================= */}}
+
+{{ define "RequestRequiredFields" }}
+{{- range .RequiredFields }}
+		{{.FieldName}}: plan.{{.AttributeName}}.{{.ValueAccessor}},
+{{- end }}
+{{ end }}
+
+{{ define "RequestCollectionFields" }}
+{{- range .CollectionFields }}
+{{- if .IsList }}
+	list{{.FieldName}}, diags := types.ListValue(
+		plan.{{.AttributeName}}.ElementType(ctx),
+		plan.{{.AttributeName}}.Elements(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams.{{.FieldName}} = list{{.FieldName}}
+{{ else }}
+	obj{{.FieldName}}, diags := types.ObjectValue(
+		plan.{{.AttributeName}}.AttributeTypes(ctx),
+		plan.{{.AttributeName}}.Attributes(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams.{{.FieldName}} = obj{{.FieldName}}
+{{ end }}
+{{- end }}
+{{ end }}
+
+{{ define "RequestOptionalFields" }}
+{{- range .OptionalFields }}
+	if !plan.{{.AttributeName}}.IsNull() && !plan.{{.AttributeName}}.IsUnknown(){{ if $.PartialUpdate }} && !plan.{{.AttributeName}}.Equal(state.{{.AttributeName}}){{ end }} {
+{{- if .IsList }}
+		list{{.FieldName}}, diags := types.ListValue(
+			plan.{{.AttributeName}}.ElementType(ctx),
+			plan.{{.AttributeName}}.Elements(),
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		reqParams.{{.FieldName}} = list{{.FieldName}}
+{{- else if .IsObject }}
+		obj{{.FieldName}}, diags := types.ObjectValue(
+			plan.{{.AttributeName}}.AttributeTypes(ctx),
+			plan.{{.AttributeName}}.Attributes(),
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		reqParams.{{.FieldName}} = obj{{.FieldName}}
+{{- else }}
+		v := plan.{{.AttributeName}}.{{.ValueAccessor}}
+		reqParams.{{.FieldName}} = &v
+{{- end }}
+	}{{ if and $.PartialUpdate .IsNullable }} else if plan.{{.AttributeName}}.IsNull() && !state.{{.AttributeName}}.IsNull() {
+		reqParams.NullFields = append(reqParams.NullFields, "{{.Name}}")
+	}{{ end }}
+{{ end }}
+{{ end }}
+
+{{ define "RequestStateFields" }}
+{{- range .RequiredFields }}
+		{{.FieldName}}: rs.Primary.Attributes["{{.StateKey}}"],
+{{- end }}
+{{ end }}
+
+{{ define "RequestChangedCondition" }}
+{{- range $idx, $field := .ChangeFields }}
+{{- if $idx }} || {{ end }}!plan.{{$field.AttributeName}}.Equal(state.{{$field.AttributeName}})
+{{- end }}
+{{- end }}
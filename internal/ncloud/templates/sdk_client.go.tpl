@@ -0,0 +1,476 @@
+{{ define "SDK_Client" }}
+{{- /* =================================================================================
+ * SDK Client Template
+ * Required data are as follows
+ *
+		PackageName string
+ * ================================================================================= */}}
+package {{.PackageName}}
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Client sends signed requests to the NCloud API gateway.
+type Client struct {
+	BaseURL    string
+	AccessKey  string
+	SecretKey  string
+	HTTPClient *http.Client
+}
+
+func NewClient(baseURL, accessKey, secretKey string) *Client {
+	return &Client{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		HTTPClient: &http.Client{},
+	}
+}
+
+// APIError is returned when the API responds with a non 2xx status code.
+type APIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+
+	// Code is the error code found in the response body, if any.
+	Code string
+	Body string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s: status %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
+
+// NotFoundError is returned when the API reports that the requested object does not exist.
+type NotFoundError struct {
+	Method string
+	Path   string
+
+	// Err is the APIError the response was recognised from. Nil for empty responses.
+	Err error
+}
+
+func (e *NotFoundError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s %s: not found: %s", e.Method, e.Path, e.Err)
+	}
+
+	return fmt.Sprintf("%s %s: not found", e.Method, e.Path)
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// IsNotFound reports whether err tells that the requested object does not exist.
+func IsNotFound(err error) bool {
+	var notFound *NotFoundError
+	return errors.As(err, &notFound)
+}
+
+// notFound converts err into a NotFoundError when it is an APIError with one of the status codes or error codes.
+func notFound(err error, statusCodes []int, errorCodes []string) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	for _, code := range statusCodes {
+		if apiErr.StatusCode == code {
+			return &NotFoundError{Method: apiErr.Method, Path: apiErr.Path, Err: err}
+		}
+	}
+
+	for _, code := range errorCodes {
+		if apiErr.Code != "" && apiErr.Code == code {
+			return &NotFoundError{Method: apiErr.Method, Path: apiErr.Path, Err: err}
+		}
+	}
+
+	return err
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query, body map[string]interface{}) (map[string]interface{}, error) {
+	u, err := url.Parse(c.BaseURL + path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query) > 0 {
+		values := url.Values{}
+		for k, v := range query {
+			values.Set(k, fmt.Sprint(v))
+		}
+		u.RawQuery = values.Encode()
+	}
+
+	var reqBody io.Reader
+	if len(body) > 0 {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewBuffer(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := fmt.Sprintf("%d", time.Now().UnixMilli())
+
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("x-ncp-apigw-timestamp", timestamp)
+	req.Header.Add("x-ncp-iam-access-key", c.AccessKey)
+	req.Header.Add("x-ncp-apigw-signature-v2", makeSignature(method, u.RequestURI(), timestamp, c.AccessKey, c.SecretKey))
+	req.Header.Add("cache-control", "no-cache")
+	req.Header.Add("pragma", "no-cache")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &APIError{
+			Method:     method,
+			Path:       path,
+			StatusCode: resp.StatusCode,
+			Code:       errorCode(respBody),
+			Body:       string(respBody),
+		}
+	}
+
+	result := map[string]interface{}{}
+	if len(bytes.TrimSpace(respBody)) == 0 {
+		return result, nil
+	}
+
+	// Numbers are decoded as json.Number, so integers such as ids keep their precision beyond 2^53.
+	decoder := json.NewDecoder(bytes.NewReader(respBody))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response of %s %s: %w", method, path, err)
+	}
+
+	return result, nil
+}
+
+// errorCode returns the error code of an error response, e.g. {"error": {"errorCode": "..."}}
+// or {"responseError": {"returnCode": "..."}}.
+func errorCode(body []byte) string {
+	var data map[string]interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return ""
+	}
+
+	for _, obj := range []interface{}{data, data["error"], data["responseError"]} {
+		m, ok := obj.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, key := range []string{"errorCode", "returnCode", "code"} {
+			if v, ok := m[key]; ok && v != nil {
+				return fmt.Sprint(v)
+			}
+		}
+	}
+
+	return ""
+}
+
+func makeSignature(method, uri, timestamp, accessKey, secretKey string) string {
+	message := fmt.Sprintf("%s %s\n%s\n%s",
+		method,
+		uri,
+		timestamp,
+		accessKey,
+	)
+
+	h := hmac.New(sha256.New, []byte(secretKey))
+	h.Write([]byte(message))
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// expandPath substitutes every {name} segment of the path with the escaped value of its parameter.
+func expandPath(path string, params map[string]interface{}) string {
+	for k, v := range params {
+		path = strings.ReplaceAll(path, "{"+k+"}", url.PathEscape(fmt.Sprint(v)))
+	}
+
+	return path
+}
+
+// toRequestValue converts a framework value into a value which can be marshalled into the request body.
+// Object attribute names are converted from snake_case into camelCase.
+func toRequestValue(v attr.Value) (interface{}, error) {
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+
+	switch t := v.(type) {
+	case basetypes.StringValue:
+		return t.ValueString(), nil
+	case basetypes.BoolValue:
+		return t.ValueBool(), nil
+	case basetypes.Int32Value:
+		return t.ValueInt32(), nil
+	case basetypes.Int64Value:
+		return t.ValueInt64(), nil
+	case basetypes.Float32Value:
+		return t.ValueFloat32(), nil
+	case basetypes.Float64Value:
+		return t.ValueFloat64(), nil
+	case basetypes.ListValue:
+		return toRequestValues(t.Elements())
+	case basetypes.SetValue:
+		return toRequestValues(t.Elements())
+	case basetypes.ObjectValue:
+		m := make(map[string]interface{}, len(t.Attributes()))
+		for k, val := range t.Attributes() {
+			converted, err := toRequestValue(val)
+			if err != nil {
+				return nil, err
+			}
+			if converted != nil {
+				m[snakeToCamel(k)] = converted
+			}
+		}
+		return m, nil
+	}
+
+	return nil, fmt.Errorf("unsupported request value type: %T", v)
+}
+
+func toRequestValues(elements []attr.Value) ([]interface{}, error) {
+	s := make([]interface{}, 0, len(elements))
+
+	for _, e := range elements {
+		converted, err := toRequestValue(e)
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, converted)
+	}
+
+	return s, nil
+}
+
+// toObject converts a decoded response into an object, converting attribute names from camelCase into snake_case.
+func toObject(data map[string]interface{}) (types.Object, error) {
+	attrTypes := make(map[string]attr.Type, len(data))
+	attrValues := make(map[string]attr.Value, len(data))
+
+	for key, value := range data {
+		attrType, attrValue, err := toAttr(value)
+		if err != nil {
+			return types.Object{}, fmt.Errorf("error converting field %s: %w", key, err)
+		}
+
+		attrTypes[camelToSnake(key)] = attrType
+		attrValues[camelToSnake(key)] = attrValue
+	}
+
+	obj, diags := types.ObjectValue(attrTypes, attrValues)
+	if diags.HasError() {
+		return types.Object{}, fmt.Errorf("error converting object: %v", diags)
+	}
+
+	return obj, nil
+}
+
+func toAttr(value interface{}) (attr.Type, attr.Value, error) {
+	switch v := value.(type) {
+	case string:
+		return types.StringType, types.StringValue(v), nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return types.Int64Type, types.Int64Value(i), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error converting number %s: %w", v, err)
+		}
+		return types.Float64Type, types.Float64Value(f), nil
+	case float64:
+		return types.Float64Type, types.Float64Value(v), nil
+	case bool:
+		return types.BoolType, types.BoolValue(v), nil
+	case []interface{}:
+		if len(v) == 0 {
+			return types.ListType{ElemType: types.StringType}, types.ListValueMust(types.StringType, []attr.Value{}), nil
+		}
+
+		elemTypes := make([]attr.Type, len(v))
+		values := make([]attr.Value, len(v))
+		homogeneous := true
+
+		for i, item := range v {
+			elemType, val, err := toAttr(item)
+			if err != nil {
+				return nil, nil, err
+			}
+			elemTypes[i] = elemType
+			values[i] = val
+			homogeneous = homogeneous && elemType.Equal(elemTypes[0])
+		}
+
+		// Elements such as objects with different attributes can not be held by a list.
+		if !homogeneous {
+			tuple, diags := types.TupleValue(elemTypes, values)
+			if diags.HasError() {
+				return nil, nil, fmt.Errorf("error converting tuple: %v", diags)
+			}
+
+			return types.TupleType{ElemTypes: elemTypes}, tuple, nil
+		}
+
+		list, diags := types.ListValue(elemTypes[0], values)
+		if diags.HasError() {
+			return nil, nil, fmt.Errorf("error converting list: %v", diags)
+		}
+
+		return types.ListType{ElemType: elemTypes[0]}, list, nil
+	case map[string]interface{}:
+		obj, err := toObject(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		return obj.Type(context.Background()), obj, nil
+	case nil:
+		return types.StringType, types.StringNull(), nil
+	}
+
+	return nil, nil, fmt.Errorf("unsupported type: %T", value)
+}
+
+// responseObject returns the object stored under key, or the whole response when it is not wrapped.
+func responseObject(data map[string]interface{}, key string) map[string]interface{} {
+	if v, ok := data[key].(map[string]interface{}); ok {
+		return v
+	}
+
+	return data
+}
+
+// StringAt returns the value found by following the object keys (string) and array indices (int) of path from data, as a string.
+// Numbers are formatted as integers when possible, so numeric ids can be used as string ids.
+func StringAt(data interface{}, path ...interface{}) (string, error) {
+	current := data
+
+	for idx, step := range path {
+		switch s := step.(type) {
+		case string:
+			obj, ok := current.(map[string]interface{})
+			if !ok {
+				return "", fmt.Errorf("%s: expected an object, got %T", formatPath(path[:idx]), current)
+			}
+
+			v, ok := obj[s]
+			if !ok {
+				return "", fmt.Errorf("%s: not found", formatPath(path[:idx+1]))
+			}
+			current = v
+		case int:
+			list, ok := current.([]interface{})
+			if !ok {
+				return "", fmt.Errorf("%s: expected an array, got %T", formatPath(path[:idx]), current)
+			}
+
+			if s < 0 || s >= len(list) {
+				return "", fmt.Errorf("%s: index out of range with length %d", formatPath(path[:idx+1]), len(list))
+			}
+			current = list[s]
+		default:
+			return "", fmt.Errorf("unsupported path step %T", step)
+		}
+	}
+
+	switch v := current.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case json.Number:
+		return v.String(), nil
+	case nil:
+		return "", fmt.Errorf("%s: value is null", formatPath(path))
+	}
+
+	return "", fmt.Errorf("%s: expected a string or a number, got %T", formatPath(path), current)
+}
+
+// formatPath returns path the way it is written in the specification, e.g. serverInstanceList[0].serverInstanceNo.
+func formatPath(path []interface{}) string {
+	var b strings.Builder
+
+	for _, step := range path {
+		if i, ok := step.(int); ok {
+			fmt.Fprintf(&b, "[%d]", i)
+			continue
+		}
+
+		if b.Len() > 0 {
+			b.WriteString(".")
+		}
+		fmt.Fprint(&b, step)
+	}
+
+	if b.Len() == 0 {
+		return "response"
+	}
+
+	return b.String()
+}
+
+func camelToSnake(s string) string {
+	var result strings.Builder
+	for i, r := range s {
+		if i > 0 && unicode.IsUpper(r) {
+			result.WriteRune('_')
+		}
+		result.WriteRune(unicode.ToLower(r))
+	}
+	return result.String()
+}
+
+func snakeToCamel(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i]) > 0 {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+{{ end }}
@@ -0,0 +1,212 @@
+{{ define "SDK_Convert" }}
+{{- /* =================================================================================
+ * SDK Convert Template
+ * Required data are as follows
+ *
+		PackageName string
+ * ================================================================================= */}}
+package {{.PackageName}}
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Convert converts a value decoded from a response into the given schema type, e.g.
+//
+//	name, err := Convert[types.String](response.Product.Attributes()["name"], types.StringType)
+//
+// Missing and null values result in a null value of the type.
+func Convert[T attr.Value](v attr.Value, target attr.Type) (T, error) {
+	var result T
+
+	converted, err := ConvertValue(v, target)
+	if err != nil {
+		return result, err
+	}
+
+	result, ok := converted.(T)
+	if !ok {
+		return result, fmt.Errorf("unexpected value type %T for %s", converted, target)
+	}
+
+	return result, nil
+}
+
+// ConvertValue converts a value decoded from a response into the given schema type.
+func ConvertValue(v attr.Value, target attr.Type) (attr.Value, error) {
+	ctx := context.Background()
+
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return target.ValueFromTerraform(ctx, tftypes.NewValue(target.TerraformType(ctx), nil))
+	}
+
+	switch t := target.(type) {
+	case basetypes.StringType:
+		return basetypes.NewStringValue(ValueString(v)), nil
+	case basetypes.BoolType:
+		b, err := strconv.ParseBool(ValueString(v))
+		if err != nil {
+			return nil, err
+		}
+		return basetypes.NewBoolValue(b), nil
+	case basetypes.Int32Type:
+		i, err := parseInt(ValueString(v), 32)
+		if err != nil {
+			return nil, err
+		}
+		return basetypes.NewInt32Value(int32(i)), nil
+	case basetypes.Int64Type:
+		i, err := parseInt(ValueString(v), 64)
+		if err != nil {
+			return nil, err
+		}
+		return basetypes.NewInt64Value(i), nil
+	case basetypes.Float32Type:
+		f, err := strconv.ParseFloat(ValueString(v), 32)
+		if err != nil {
+			return nil, err
+		}
+		return basetypes.NewFloat32Value(float32(f)), nil
+	case basetypes.Float64Type:
+		f, err := strconv.ParseFloat(ValueString(v), 64)
+		if err != nil {
+			return nil, err
+		}
+		return basetypes.NewFloat64Value(f), nil
+	case basetypes.ListType:
+		elements, err := convertElements(v, t.ElemType)
+		if err != nil {
+			return nil, err
+		}
+		list, diags := basetypes.NewListValue(t.ElemType, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error converting list: %v", diags)
+		}
+		return list, nil
+	case basetypes.SetType:
+		elements, err := convertElements(v, t.ElemType)
+		if err != nil {
+			return nil, err
+		}
+		set, diags := basetypes.NewSetValue(t.ElemType, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error converting set: %v", diags)
+		}
+		return set, nil
+	case basetypes.ObjectType:
+		obj, ok := v.(basetypes.ObjectValue)
+		if !ok {
+			return nil, fmt.Errorf("expected an object, got %s", v)
+		}
+
+		attrs := obj.Attributes()
+		values := make(map[string]attr.Value, len(t.AttrTypes))
+		for name, attrType := range t.AttrTypes {
+			converted, err := ConvertValue(attrs[name], attrType)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			values[name] = converted
+		}
+
+		object, diags := basetypes.NewObjectValue(t.AttrTypes, values)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error converting object: %v", diags)
+		}
+		return object, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type: %s", target)
+}
+
+// parseInt parses s as an integer of the given size. Integers are parsed as is to keep their precision,
+// and numbers such as 1e3 or 3.0 are accepted when they hold an integer.
+func parseInt(s string, bitSize int) (int64, error) {
+	i, err := strconv.ParseInt(s, 10, bitSize)
+	if err == nil {
+		return i, nil
+	}
+
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil || f != math.Trunc(f) || f < -math.Pow(2, float64(bitSize-1)) || f >= math.Pow(2, float64(bitSize-1)) {
+		return 0, err
+	}
+
+	return int64(f), nil
+}
+
+func convertElements(v attr.Value, elemType attr.Type) ([]attr.Value, error) {
+	collection, ok := v.(interface{ Elements() []attr.Value })
+	if !ok {
+		return nil, fmt.Errorf("expected a collection, got %s", v)
+	}
+
+	elements := make([]attr.Value, 0, len(collection.Elements()))
+	for idx, e := range collection.Elements() {
+		converted, err := ConvertValue(e, elemType)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", idx, err)
+		}
+		elements = append(elements, converted)
+	}
+
+	return elements, nil
+}
+
+// ValueString returns the value as a plain string, without the quotes added by attr.Value.String.
+func ValueString(v attr.Value) string {
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return ""
+	}
+
+	switch t := v.(type) {
+	case basetypes.StringValue:
+		return t.ValueString()
+	case basetypes.BoolValue:
+		return strconv.FormatBool(t.ValueBool())
+	case basetypes.Int32Value:
+		return strconv.FormatInt(int64(t.ValueInt32()), 10)
+	case basetypes.Int64Value:
+		return strconv.FormatInt(t.ValueInt64(), 10)
+	case basetypes.Float32Value:
+		return strconv.FormatFloat(float64(t.ValueFloat32()), 'f', -1, 32)
+	case basetypes.Float64Value:
+		return strconv.FormatFloat(t.ValueFloat64(), 'f', -1, 64)
+	}
+
+	return v.String()
+}
+
+// AttributeAt returns the value found by following the attribute names from obj, or nil when it does not exist.
+// The leading name may refer to obj itself, e.g. "product" of product.product_id, in which case it is skipped.
+func AttributeAt(obj basetypes.ObjectValue, path ...string) attr.Value {
+	var current attr.Value = obj
+
+	for idx, name := range path {
+		o, ok := current.(basetypes.ObjectValue)
+		if !ok {
+			return nil
+		}
+
+		next, ok := o.Attributes()[name]
+		if !ok {
+			if idx == 0 && len(path) > 1 {
+				continue
+			}
+			return nil
+		}
+
+		current = next
+	}
+
+	return current
+}
+
+{{ end }}
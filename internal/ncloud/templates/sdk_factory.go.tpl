@@ -0,0 +1,108 @@
+{{ define "SDK_Factory" }}
+{{- /* =================================================================================
+ * SDK Factory Template
+ * Required data are as follows
+ *
+		PackageName string
+ * ================================================================================= */}}
+package {{.PackageName}}
+
+import (
+	"net/http"
+	"os"
+	"strings"
+)
+
+// EndpointOverrideEnv is the environment variable overriding the endpoint of every client, e.g. to test against a local server.
+const EndpointOverrideEnv = "NCLOUD_ENDPOINT"
+
+// ClientFactory is implemented by the provider data passed to generated resources and data sources,
+// which create their client in Configure.
+type ClientFactory interface {
+	NewClient(endpoints Endpoints) *Client
+}
+
+// Endpoints are the endpoints of a service.
+type Endpoints struct {
+	// Default is the endpoint of the public site. Sites which are not listed in Sites are served under the same path
+	// of fin-ntruss.com and gov-ntruss.com.
+	Default string
+
+	// Sites maps sites and regions to endpoints, e.g. Sites["fin"]["KR"].
+	// The "default" region is used for regions which are not listed.
+	Sites map[string]map[string]string
+}
+
+// Config holds the provider level settings shared by every client.
+type Config struct {
+	AccessKey string
+	SecretKey string
+
+	// Site is one of "public", "fin" or "gov". Empty means "public".
+	Site string
+
+	// Region is the region code, e.g. "KR".
+	Region string
+}
+
+// Factory creates clients from the provider configuration. Build it once within the provider's Configure
+// and make it reachable from the provider data, e.g. by embedding it:
+//
+//	type ProviderConfig struct {
+//		*ncloudsdk.Factory
+//		...
+//	}
+type Factory struct {
+	Config     Config
+	HTTPClient *http.Client
+}
+
+var _ ClientFactory = &Factory{}
+
+func NewFactory(config Config) *Factory {
+	return &Factory{
+		Config:     config,
+		HTTPClient: &http.Client{},
+	}
+}
+
+// NewClient returns a client calling the endpoint of the configured site and region with the configured credentials.
+func (f *Factory) NewClient(endpoints Endpoints) *Client {
+	c := NewClient(f.Endpoint(endpoints), f.Config.AccessKey, f.Config.SecretKey)
+	if f.HTTPClient != nil {
+		c.HTTPClient = f.HTTPClient
+	}
+
+	return c
+}
+
+// Endpoint returns the endpoint of the configured site and region, unless EndpointOverrideEnv is set.
+func (f *Factory) Endpoint(endpoints Endpoints) string {
+	if endpoint := os.Getenv(EndpointOverrideEnv); endpoint != "" {
+		return endpoint
+	}
+
+	site := f.Config.Site
+	if site == "" {
+		site = "public"
+	}
+
+	if regions, ok := endpoints.Sites[site]; ok {
+		if endpoint, ok := regions[f.Config.Region]; ok {
+			return endpoint
+		}
+
+		if endpoint, ok := regions["default"]; ok {
+			return endpoint
+		}
+	}
+
+	switch site {
+	case "fin", "gov":
+		return strings.Replace(endpoints.Default, ".ntruss.com", "."+site+"-ntruss.com", 1)
+	default:
+		return endpoints.Default
+	}
+}
+
+{{ end }}
@@ -0,0 +1,120 @@
+{{ define "SDK_Operation" }}
+{{- /* =================================================================================
+ * SDK Operation Template
+ * Required data are as follows
+ *
+		PackageName    string
+		Operation      *SDKOperation
+ * ================================================================================= */}}
+package {{.PackageName}}
+
+import (
+	"context"
+{{- if .Operation.NeedsTypes }}
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+{{- end }}
+)
+
+{{- with .Operation }}
+
+type Primitive{{.MethodName}}Request struct {
+{{- range .Fields }}
+	{{.FieldName}} {{.GoType}} `json:"{{.Name}}{{if not .Required}},omitempty{{end}}"`
+{{- end }}
+{{- if .HasNullableFields }}
+
+	// NullFields are the names of optional body fields sent as null, to clear their value.
+	NullFields []string `json:"-"`
+{{- end }}
+}
+{{- if .ResponseFields }}
+
+type Primitive{{.MethodName}}Response struct {
+{{- range .ResponseFields }}
+	{{.FieldName}} types.Object
+{{- end }}
+}
+{{- end }}
+
+// {{.MethodName}}_TF calls {{.HTTPMethod}} {{.Path}}
+func (c *Client) {{.MethodName}}_TF(ctx context.Context, r *Primitive{{.MethodName}}Request) ({{if .ResponseFields}}*Primitive{{.MethodName}}Response{{else}}map[string]interface{}{{end}}, error) {
+	path := expandPath("{{.Path}}", map[string]interface{}{
+{{- range .PathFields }}
+		"{{.Name}}": r.{{.FieldName}},
+{{- end }}
+	})
+
+	query := map[string]interface{}{
+{{- range .QueryFields }}{{ if .Required }}
+		"{{.Name}}": r.{{.FieldName}},
+{{- end }}{{ end }}
+	}
+{{- range .QueryFields }}{{ if not .Required }}
+
+	if r.{{.FieldName}} != nil {
+		query["{{.Name}}"] = *r.{{.FieldName}}
+	}
+{{- end }}{{ end }}
+
+	body := map[string]interface{}{}
+{{- range .BodyFields }}
+{{- if .IsScalar }}
+{{- if .Required }}
+	body["{{.Name}}"] = r.{{.FieldName}}
+{{- else }}
+
+	if r.{{.FieldName}} != nil {
+		body["{{.Name}}"] = *r.{{.FieldName}}
+	}
+{{- end }}
+{{- else }}
+
+	if v, err := toRequestValue(r.{{.FieldName}}); err != nil {
+		return nil, err
+	} else if v != nil {
+		body["{{.Name}}"] = v
+	}
+{{- end }}
+{{- end }}
+{{- if .HasNullableFields }}
+
+	for _, name := range r.NullFields {
+		body[name] = nil
+	}
+{{- end }}
+
+	data, err := c.do(ctx, "{{.HTTPMethod}}", path, query, body)
+	if err != nil {
+{{- with .NotFound }}
+		return nil, notFound(err, []int{ {{- range $idx, $code := .StatusCodes }}{{ if $idx }}, {{ end }}{{ $code }}{{ end }}}, []string{ {{- range $idx, $code := .ErrorCodes }}{{ if $idx }}, {{ end }}{{ printf "%q" $code }}{{ end }}})
+{{- else }}
+		return nil, err
+{{- end }}
+	}
+{{- if and .NotFound .NotFound.EmptyBody }}
+
+	if len(data) == 0 {
+		return nil, &NotFoundError{Method: "{{.HTTPMethod}}", Path: path}
+	}
+{{- end }}
+{{- if .ResponseFields }}
+
+	response := &Primitive{{.MethodName}}Response{}
+{{- range .ResponseFields }}
+
+	response.{{.FieldName}}, err = toObject(responseObject(data, "{{.Key}}"))
+	if err != nil {
+		return nil, err
+	}
+{{- end }}
+
+	return response, nil
+{{- else }}
+
+	return data, nil
+{{- end }}
+}
+{{- end }}
+
+{{ end }}
@@ -1,5 +1,5 @@
 {{ define "Test_DataSource" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Test Template
  * Required data are as follows
  *
@@ -7,7 +7,7 @@
 		DataSourceName string
 		PackageName    string
 		ConfigParams   string
- * ================================================================================= */
+ * ================================================================================= */}}
 
 package {{.PackageName}}_test
 
@@ -19,7 +19,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	. "github.com/NaverCloudPlatform/terraform-codegen-poc/internal/test"
+	"github.com/NaverCloudPlatform/terraform-codegen-poc/internal/test"
 )
 
 func TestAccDataSourceNcloud{{.ProviderName | ToPascalCase}}_{{.DataSourceName | ToLowerCase}}_basic(t *testing.T) {
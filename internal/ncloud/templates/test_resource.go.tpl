@@ -1,27 +1,29 @@
 {{ define "Test" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Test Template
  * Required data are as follows
  *
-		ProviderName               string
-		ResourceName               string
-		PackageName                string
-		RefreshObjectName          string
-		ReadMethod                 string
-		ReadMethodName             string
-		ReadReqBody                string
-		Endpoint                   string
-		ReadPathParams             string
-		ConfigParams               string
-		ReadReqBodyForCheckExist   string
-		ReadReqBodyForCheckDestroy string
- * ================================================================================= */
+		ProviderName      string
+		ResourceName      string
+		PackageName       string
+		RefreshObjectName string
+		ReadOp            *Operation
+		ReadMethod        string
+		ReadMethodName    string
+		Endpoints         string
+		CreateStep        *TestStep
+		UpdateStep        *TestStep
+		ImportState       *ImportState
+		IgnoreTimeouts    bool
+ * ================================================================================= */}}
 
 package {{.PackageName}}_test
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -29,11 +31,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/NaverCloudPlatform/terraform-codegen-poc/internal/test"
-	"github.com/NaverCloudPlatform/terraform-codegen-poc/internal/ncloudsdk"
 )
 
 func TestAccResourceNcloud{{.ProviderName | ToPascalCase}}_{{.ResourceName | ToLowerCase}}_basic(t *testing.T) {
-	{{.ResourceName | ToCamelCase}}Name := fmt.Sprintf("tf-{{.ResourceName | ToCamelCase}}-%s", acctest.RandString(5))
+	suffix := acctest.RandString(5)
 
 	resourceName := "ncloud_{{.ProviderName | ToLowerCase}}_{{.ResourceName | ToLowerCase}}.testing_{{.ResourceName | ToLowerCase}}"
 
@@ -43,69 +44,126 @@ func TestAccResourceNcloud{{.ProviderName | ToPascalCase}}_{{.ResourceName | ToL
 		CheckDestroy:             testAccCheck{{.ResourceName | ToPascalCase}}Destroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAcc{{.ResourceName | ToLowerCase}}Config({{.ResourceName | ToCamelCase}}Name),
+				Config: testAcc{{.ResourceName | ToLowerCase}}Config(suffix),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheck{{.ResourceName | ToLowerCase}}Exists(resourceName, test.GetTestProvider(true)),
-					resource.TestCheckResourceAttr(resourceName, "{{.ResourceName | ToSnakeCase}}_name", {{.ResourceName | ToCamelCase}}Name),
-                    // check all the other attributes
+					{{- range .CreateStep.Checks }}
+					resource.TestCheckResourceAttr(resourceName, "{{.Key}}", {{.Value}}),
+					{{- end }}
 				),
 			},
+			{{- if .UpdateStep }}
+			{
+				Config: testAcc{{.ResourceName | ToLowerCase}}ConfigUpdate(suffix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheck{{.ResourceName | ToLowerCase}}Exists(resourceName, test.GetTestProvider(true)),
+					{{- range .UpdateStep.Checks }}
+					resource.TestCheckResourceAttr(resourceName, "{{.Key}}", {{.Value}}),
+					{{- end }}
+				),
+			},
+			{{- end }}
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				{{- if .ImportState }}
+				ImportStateIdFunc: testAcc{{.ResourceName | ToLowerCase}}ImportStateID(resourceName),
+				{{- end }}
+				{{- if .IgnoreTimeouts }}
+				ImportStateVerifyIgnore: []string{"timeouts"},
+				{{- end }}
+			},
 		},
 	})
 }
 
 func testAccCheck{{.ResourceName | ToLowerCase}}Exists(n string, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		resource, ok := s.RootModule().Resources[n]
+		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("not found %s", n)
 		}
 
-		if resource.Primary.ID == "" {
+		if rs.Primary.ID == "" {
 			return fmt.Errorf("no ID is set")
 		}
 
-		c := ncloudsdk.NewClient("https://apigateway.apigw.ntruss.com/api/v1", os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"))
+		c := testAcc{{.ResourceName | ToLowerCase}}Client()
 
-		response, err := c.{{.ReadMethodName}}_TF(context.Background(), &ncloudsdk.Primitive{{.ReadMethodName}}Request{
-            // change value with "resource.Primary.ID"
-            {{.ReadReqBodyForCheckExist}}
+		_, err := c.{{.ReadMethodName}}_TF(context.Background(), &ncloudsdk.Primitive{{.ReadMethodName}}Request{
+			{{- template "RequestStateFields" .ReadOp }}
 		})
-		if response == nil {
-			return err
-		}
-		if err != nil {
-			return err
-		}
 
-		return nil
+		return err
 	}
 }
 
 func testAccCheck{{.ResourceName | ToPascalCase}}Destroy(s *terraform.State) error {
+	c := testAcc{{.ResourceName | ToLowerCase}}Client()
+
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "ncloud_{{.ProviderName | ToLowerCase}}_{{.ResourceName | ToLowerCase}}.testing_{{.ResourceName | ToLowerCase}}" {
+		if rs.Type != "ncloud_{{.ProviderName | ToLowerCase}}_{{.ResourceName | ToLowerCase}}" {
 			continue
 		}
 
-		c := ncloudsdk.NewClient("https://apigateway.apigw.ntruss.com/api/v1", os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"))
 		_, err := c.{{.ReadMethodName}}_TF(context.Background(), &ncloudsdk.Primitive{{.ReadMethodName}}Request{
-            // change value with "rs.Primary.ID"
-            {{.ReadReqBodyForCheckDestroy}}
+			{{- template "RequestStateFields" .ReadOp }}
 		})
+		if ncloudsdk.IsNotFound(err) {
+			continue
+		}
 		if err != nil {
-			return nil
+			return err
 		}
+
+		return fmt.Errorf("{{.ResourceName}} %s still exists", rs.Primary.ID)
 	}
 
 	return nil
 }
 
-func testAcc{{.ResourceName | ToLowerCase}}Config({{.ResourceName | ToCamelCase}}Name string) string {
+// testAcc{{.ResourceName | ToLowerCase}}Client returns a client of the site and region the acceptance tests run against.
+func testAcc{{.ResourceName | ToLowerCase}}Client() *ncloudsdk.Client {
+	return ncloudsdk.NewFactory(ncloudsdk.Config{
+		AccessKey: os.Getenv("NCLOUD_ACCESS_KEY"),
+		SecretKey: os.Getenv("NCLOUD_SECRET_KEY"),
+		Site:      os.Getenv("NCLOUD_SITE"),
+		Region:    os.Getenv("NCLOUD_REGION"),
+	}).NewClient({{.Endpoints}})
+}
+{{- if .ImportState }}
+
+func testAcc{{.ResourceName | ToLowerCase}}ImportStateID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found %s", n)
+		}
+
+		return strings.Join([]string{
+			{{- range .ImportState.Parts }}
+			rs.Primary.Attributes["{{.Attribute}}"],
+			{{- end }}
+		}, {{ printf "%q" .ImportState.Separator }}), nil
+	}
+}
+{{- end }}
+
+func testAcc{{.ResourceName | ToLowerCase}}Config(suffix string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_{{.ProviderName | ToLowerCase}}_{{.ResourceName | ToLowerCase}}" "testing_{{.ResourceName | ToLowerCase}}" {
+{{.CreateStep.Config -}}
+	}`, suffix)
+}
+{{- if .UpdateStep }}
+
+func testAcc{{.ResourceName | ToLowerCase}}ConfigUpdate(suffix string) string {
 	return fmt.Sprintf(`
 	resource "ncloud_{{.ProviderName | ToLowerCase}}_{{.ResourceName | ToLowerCase}}" "testing_{{.ResourceName | ToLowerCase}}" {
-		{{.ConfigParams}}
-	}`, {{.ResourceName | ToCamelCase}}Name)
+{{.UpdateStep.Config -}}
+	}`, suffix)
 }
+{{- end }}
 
 {{ end }}
\ No newline at end of file
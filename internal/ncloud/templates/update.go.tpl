@@ -1,63 +1,92 @@
 {{ define "Update" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Update Template
  * Required data are as follows
  *
-		IsUpdateExists         bool
- 		ResourceName           string
-		RefreshObjectName      string
-		UpdateReqBody          string
-		UpdateReqListParam     string
-		UpdateReqObjectParam   string
-		UpdateReqOptionalParam string
-		UpdateMethod           string
-		UpdateMethodName       string
-		Endpoint               string
-		UpdatePathParams       string
-		ReadPathParams         string
- * ================================================================================= */
+		IsUpdateExists    bool
+		ResourceName      string
+		RefreshObjectName string
+		UpdateOps         []*Operation
+		IsUpdateWaited    bool
+		Timeouts          *Timeouts
+ * ================================================================================= */}}
 
 func (a *{{.ResourceName | ToCamelCase}}Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	{{ if .IsUpdateExists }}
-
-	var plan {{.RefreshObjectName | ToPascalCase}}Model
+	{{- if .IsUpdateExists }}
+	var plan, state {{.RefreshObjectName | ToPascalCase}}Model
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
- 	reqParams := &ncloudsdk.Primitive{{.UpdateMethodName}}Request{
-		{{.UpdateReqBody}}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	{{.UpdateReqListParam}}
+	// The identifier is not planned, so path parameters holding it are read from the state
+	plan.ID = state.ID
+	{{- if .Timeouts }}
 
-	{{.UpdateReqObjectParam}}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, {{.Timeouts.Update}})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	{{- end }}
 
-	{{.UpdateReqOptionalParam}}
+	{{ range .UpdateOps }}
+	// {{.Method}} {{.Path}}
+	{{- if .ChangeFields }}
+	if {{ template "RequestChangedCondition" . }} {
+	{{- else }}
+	{
+	{{- end }}
+		reqParams := &ncloudsdk.Primitive{{.MethodName}}Request{
+			{{- template "RequestRequiredFields" . }}
+		}
 
-	tflog.Info(ctx, "Update{{.UpdateMethodName}} reqParams="+common.MarshalUncheckedString(reqParams))
+		{{- template "RequestCollectionFields" . }}
 
-	c := ncloudsdk.NewClient("{{.Endpoint}}", os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"))
+		{{- template "RequestOptionalFields" . }}
 
-	response, err := c.{{.UpdateMethodName}}_TF(ctx, reqParams)
-	if err != nil {
+		tflog.Info(ctx, "Update{{.MethodName}} reqParams="+common.MarshalUncheckedString(reqParams))
+
+		response, err := a.client.{{.MethodName}}_TF(ctx, reqParams)
+		if err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+		if response == nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", "response invalid")
+			return
+		}
+
+		tflog.Info(ctx, "Update{{.MethodName}} response="+common.MarshalUncheckedString(response))
+	}
+	{{ end }}
+	{{- if .IsUpdateWaited }}
+	if err := plan.waitResourceUpdated(ctx, a.client, plan.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
 		return
 	}
-		if response == nil {
-		resp.Diagnostics.AddError("UPDATING ERROR", "response invalid")
+	{{ end }}
+	exists := plan.refreshFromOutput(ctx, a.client, &resp.Diagnostics, plan.ID.ValueString())
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Info(ctx, "Update{{.UpdateMethodName}} response="+common.MarshalUncheckedString(response))
-
-	plan.refreshFromOutput(ctx, &resp.Diagnostics, plan.ID.ValueString())
+	if !exists {
+		resp.Diagnostics.AddError("UPDATING ERROR", "{{.ResourceName | ToPascalCase}} was not found after update")
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
-
-	{{ end}}
+	{{- end }}
 }
 
-{{ end }}
\ No newline at end of file
+{{ end }}
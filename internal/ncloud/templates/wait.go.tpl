@@ -1,36 +1,57 @@
 {{ define "Wait" }}
-/* =================================================================================
+{{- /* =================================================================================
  * Wait Template
  * Required data are as follows
  *
+		ReadOp            *Operation
 		ReadMethod        string
 		ReadMethodName    string
-		Endpoint          string
-		ReadPathParams    string
 		RefreshObjectName string
-		ReadReqBody       string
- * ================================================================================= */
+		Wait              *Wait
+		Timeouts          *Timeouts
+ * ================================================================================= */}}
 
-func (plan *{{.RefreshObjectName | ToPascalCase}}Model) waitResourceCreated(ctx context.Context, id string) error {
+func (plan *{{.RefreshObjectName | ToPascalCase}}Model) waitResourceCreated(ctx context.Context, c *ncloudsdk.Client, id string) error {
+	{{- if .Timeouts }}
+	timeout, diags := plan.Timeouts.Create(ctx, {{.Timeouts.Create}})
+	if diags.HasError() {
+		return fmt.Errorf("error occured while reading the create timeout: %v", diags)
+	}
+{{ end }}
 	stateConf := &retry.StateChangeConf{
+		{{- if .Wait.Create }}
+		Pending: []string{"CREATING"{{ range .Wait.Create.Pending }}, {{ printf "%q" . }}{{ end }}},
+		Target:  []string{ {{- template "WaitStateList" .Wait.Create.Target }}},
+		{{- else }}
 		Pending: []string{"CREATING"},
 		Target:  []string{"CREATED"},
+		{{- end }}
 		Refresh: func() (interface{}, string, error) {
-			c := ncloudsdk.NewClient("{{.Endpoint}}", os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"))
 			response, err := c.{{.ReadMethodName}}_TF(ctx, &ncloudsdk.Primitive{{.ReadMethodName}}Request{
-					// need to use id
-					{{.ReadReqBody}}
+				{{- template "RequestRequiredFields" .ReadOp }}
 			})
-			if err != nil {
+			// The resource may not be readable right after its creation.
+			if ncloudsdk.IsNotFound(err) {
 				return response, "CREATING", nil
 			}
+			if err != nil {
+				return nil, "", err
+			}
+			{{- if .Wait.Create }}
+
+			status := {{.Wait.StatusGetter}}
+			{{- template "WaitFailure" .Wait.Create }}
+
+			return response, status, nil
+			{{- else }}
 			if response != nil {
 				return response, "CREATED", nil
 			}
 
 			return response, "CREATING", nil
+			{{- end }}
 		},
-		Timeout:    conn.DefaultTimeout,
+		Timeout:    {{ if .Timeouts }}timeout{{ else }}conn.DefaultTimeout{{ end }},
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -40,24 +61,78 @@ func (plan *{{.RefreshObjectName | ToPascalCase}}Model) waitResourceCreated(ctx
 	}
 	return nil
 }
+{{ if .Wait.Update }}
+func (plan *{{.RefreshObjectName | ToPascalCase}}Model) waitResourceUpdated(ctx context.Context, c *ncloudsdk.Client, id string) error {
+	{{- if .Timeouts }}
+	timeout, diags := plan.Timeouts.Update(ctx, {{.Timeouts.Update}})
+	if diags.HasError() {
+		return fmt.Errorf("error occured while reading the update timeout: %v", diags)
+	}
+{{ end }}
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ {{- template "WaitStateList" .Wait.Update.Pending }}},
+		Target:  []string{ {{- template "WaitStateList" .Wait.Update.Target }}},
+		Refresh: func() (interface{}, string, error) {
+			response, err := c.{{.ReadMethodName}}_TF(ctx, &ncloudsdk.Primitive{{.ReadMethodName}}Request{
+				{{- template "RequestRequiredFields" .ReadOp }}
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := {{.Wait.StatusGetter}}
+			{{- template "WaitFailure" .Wait.Update }}
+
+			return response, status, nil
+		},
+		Timeout:    {{ if .Timeouts }}timeout{{ else }}conn.DefaultTimeout{{ end }},
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
 
-func (plan *{{.RefreshObjectName | ToPascalCase}}Model) waitResourceDeleted(ctx context.Context, id string) error {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error occured while waiting for resource to be updated: %s", err)
+	}
+	return nil
+}
+{{ end }}
+func (plan *{{.RefreshObjectName | ToPascalCase}}Model) waitResourceDeleted(ctx context.Context, c *ncloudsdk.Client, id string) error {
+	{{- if .Timeouts }}
+	timeout, diags := plan.Timeouts.Delete(ctx, {{.Timeouts.Delete}})
+	if diags.HasError() {
+		return fmt.Errorf("error occured while reading the delete timeout: %v", diags)
+	}
+{{ end }}
 	stateConf := &retry.StateChangeConf{
+		{{- if .Wait.Delete }}
+		Pending: []string{"DELETING"{{ range .Wait.Delete.Pending }}, {{ printf "%q" . }}{{ end }}},
+		Target:  []string{"DELETED"{{ range .Wait.Delete.Target }}, {{ printf "%q" . }}{{ end }}},
+		{{- else }}
 		Pending: []string{"DELETING"},
 		Target:  []string{"DELETED"},
+		{{- end }}
 		Refresh: func() (interface{}, string, error) {
-			c := ncloudsdk.NewClient("{{.Endpoint}}", os.Getenv("NCLOUD_ACCESS_KEY"), os.Getenv("NCLOUD_SECRET_KEY"))
 			response, err := c.{{.ReadMethodName}}_TF(ctx, &ncloudsdk.Primitive{{.ReadMethodName}}Request{
-					// need to use id
-					{{.ReadReqBody}}
+				{{- template "RequestRequiredFields" .ReadOp }}
 			})
-			if err == nil {
+			if ncloudsdk.IsNotFound(err) {
 				return response, "DELETED", nil
 			}
+			if err != nil {
+				return nil, "", err
+			}
+			{{- if .Wait.Delete }}
+
+			status := {{.Wait.StatusGetter}}
+			{{- template "WaitFailure" .Wait.Delete }}
+
+			return response, status, nil
+			{{- else }}
 
 			return response, "DELETING", nil
+			{{- end }}
 		},
-		Timeout:    conn.DefaultTimeout,
+		Timeout:    {{ if .Timeouts }}timeout{{ else }}conn.DefaultTimeout{{ end }},
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -68,4 +143,18 @@ func (plan *{{.RefreshObjectName | ToPascalCase}}Model) waitResourceDeleted(ctx
 	return nil
 }
 
-{{ end }}
\ No newline at end of file
+{{ end }}
+
+{{ define "WaitStateList" }}
+{{- range $idx, $state := . }}{{ if $idx }}, {{ end }}{{ printf "%q" $state }}{{ end }}
+{{- end }}
+
+{{ define "WaitFailure" }}
+{{- if .Failure }}
+
+			switch status {
+			case {{ template "WaitStateList" .Failure }}:
+				return response, status, fmt.Errorf("resource reached a failure state: %s", status)
+			}
+{{- end }}
+{{- end }}
@@ -0,0 +1,677 @@
+package ncloud
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+)
+
+// testSuffixLength is the length of the random suffix generated by the acceptance tests.
+const testSuffixLength = 5
+
+// TestCheck is a value of the state checked by a generated acceptance test step.
+type TestCheck struct {
+	// Key is the flatmap key of the value in the state, e.g. tags.# or tags.0.
+	Key string
+
+	// Value is the Go expression of the expected value. Generated strings are built from the "suffix" variable.
+	Value string
+}
+
+// TestStep holds the configuration and checks of a generated acceptance test step.
+type TestStep struct {
+	// Config is the body of the resource block. Strings contain %[1]s, which is replaced by a random suffix at runtime.
+	Config string
+	Checks []*TestCheck
+}
+
+// MakeTestSteps returns the create step, which sets every required and optional attribute of the schema,
+// and the update step, which changes the attributes accepted by the update operations.
+// Values follow the type, validators and default of each attribute, and the examples of the create operation fields.
+// The update step is nil when no update operation accepts one of the attributes.
+func MakeTestSteps(attributes resource.Attributes, createOp *Operation, updateOps []*Operation) (*TestStep, *TestStep) {
+	mutable := make(map[string]bool)
+	for _, op := range updateOps {
+		for _, f := range op.ChangeFields() {
+			mutable[testAttributeName(f.Name)] = true
+		}
+	}
+
+	examples := make(map[string]any)
+	if createOp != nil {
+		for _, f := range createOp.Fields {
+			if f.Example != nil {
+				examples[testAttributeName(f.Name)] = f.Example
+			}
+		}
+	}
+
+	create := &TestStep{}
+	update := &TestStep{}
+	isUpdated := false
+
+	var createConfig, updateConfig strings.Builder
+
+	for _, attr := range attributes {
+		if !isConfigurable(attr) {
+			continue
+		}
+
+		value, checks, ok := testValue(attr, false, examples[attr.Name])
+		if !ok {
+			continue
+		}
+
+		createConfig.WriteString(fmt.Sprintf("\t\t%s = %s\n", attr.Name, value))
+		create.Checks = append(create.Checks, checks...)
+
+		if mutable[attr.Name] {
+			if updated, updatedChecks, _ := testValue(attr, true, examples[attr.Name]); updated != value {
+				value, checks = updated, updatedChecks
+				isUpdated = true
+			}
+		}
+
+		updateConfig.WriteString(fmt.Sprintf("\t\t%s = %s\n", attr.Name, value))
+		update.Checks = append(update.Checks, checks...)
+	}
+
+	create.Config = createConfig.String()
+	update.Config = updateConfig.String()
+
+	if !isUpdated {
+		return create, nil
+	}
+
+	return create, update
+}
+
+// MakeDataSourceTestTFConfig returns the body of the data source block, which sets every required read parameter.
+// Values follow the type of the parameter and its example. Strings use the %[1]s verb, replaced by a random name at runtime.
+func MakeDataSourceTestTFConfig(readParams *util.RequestParameters) string {
+	var t strings.Builder
+
+	if readParams == nil {
+		return ""
+	}
+
+	for _, val := range readParams.Required {
+		var e specschema.ElementType
+
+		switch val.Type {
+		case "integer":
+			e.Int64 = &specschema.Int64Type{}
+		case "number":
+			e.Float64 = &specschema.Float64Type{}
+		case "boolean":
+			e.Bool = &specschema.BoolType{}
+		case "array":
+			t.WriteString(fmt.Sprintf("\t\t%s = []\n", testAttributeName(val.Name)))
+			continue
+		default:
+			e.String = &specschema.StringType{}
+		}
+
+		value, _ := testElementValue(e, false, val.Example)
+		if e.String != nil && !isExampleString(val.Example) {
+			value = `"%[1]s"`
+		}
+
+		t.WriteString(fmt.Sprintf("\t\t%s = %s\n", testAttributeName(val.Name), value))
+	}
+
+	return t.String()
+}
+
+// testAttributeName returns the schema attribute name of an API field.
+func testAttributeName(name string) string {
+	return PascalToSnakeCase(strings.ReplaceAll(name, "-", "_"))
+}
+
+// isConfigurable reports whether the attribute can be set in the configuration.
+func isConfigurable(attr resource.Attribute) bool {
+	switch attributeBehavior(attr) {
+	case specschema.Required, specschema.Optional, specschema.ComputedOptional:
+		return true
+	default:
+		return false
+	}
+}
+
+// testValue returns the HCL value of the attribute and the checks of the resulting state, keyed from the attribute name.
+// Updated values differ from the initial ones when the type and validators allow it.
+func testValue(attr resource.Attribute, updated bool, example any) (string, []*TestCheck, bool) {
+	if value, expected, ok := testPrimitiveValue(attr, updated, example); ok {
+		return value, []*TestCheck{{Key: attr.Name, Value: expected}}, true
+	}
+
+	var (
+		value  string
+		checks []*TestCheck
+		ok     bool
+	)
+
+	switch {
+	case attr.List != nil:
+		value, checks, ok = testCollectionValue(attr.List.ElementType, updated, true)
+	case attr.Set != nil:
+		value, checks, ok = testCollectionValue(attr.Set.ElementType, updated, false)
+	case attr.Map != nil:
+		value, checks, ok = testMapValue(attr.Map.ElementType, updated)
+	case attr.Object != nil:
+		value, checks, ok = testObjectValue(attr.Object.AttributeTypes, updated)
+	case attr.SingleNested != nil:
+		value, checks, ok = testNestedValue(attr.SingleNested.Attributes, updated)
+	case attr.ListNested != nil:
+		value, checks, ok = testNestedValue(attr.ListNested.NestedObject.Attributes, updated)
+		value, checks = "["+value+"]", append([]*TestCheck{{Key: "#", Value: `"1"`}}, prefixTestChecks("0", checks)...)
+	case attr.SetNested != nil:
+		value, _, ok = testNestedValue(attr.SetNested.NestedObject.Attributes, updated)
+		value, checks = "["+value+"]", []*TestCheck{{Key: "#", Value: `"1"`}}
+	case attr.MapNested != nil:
+		value, checks, ok = testNestedValue(attr.MapNested.NestedObject.Attributes, updated)
+		value, checks = "{ key = "+value+" }", append([]*TestCheck{{Key: "%", Value: `"1"`}}, prefixTestChecks("key", checks)...)
+	}
+
+	if !ok {
+		return "", nil, false
+	}
+
+	return value, prefixTestChecks(attr.Name, checks), true
+}
+
+// prefixTestChecks returns the checks with their keys nested under prefix. Checks with an empty key take the prefix as key.
+func prefixTestChecks(prefix string, checks []*TestCheck) []*TestCheck {
+	prefixed := make([]*TestCheck, 0, len(checks))
+
+	for _, c := range checks {
+		key := prefix
+		if c.Key != "" {
+			key += "." + c.Key
+		}
+		prefixed = append(prefixed, &TestCheck{Key: key, Value: c.Value})
+	}
+
+	return prefixed
+}
+
+// testCollectionValue returns a list or set holding a single element. Elements of sets are not checked, as their keys are not stable.
+func testCollectionValue(e specschema.ElementType, updated, indexed bool) (string, []*TestCheck, bool) {
+	value, checks, ok := testElementTypeValue(e, updated)
+	if !ok {
+		return "", nil, false
+	}
+
+	if !indexed {
+		return "[" + value + "]", []*TestCheck{{Key: "#", Value: `"1"`}}, true
+	}
+
+	return "[" + value + "]", append([]*TestCheck{{Key: "#", Value: `"1"`}}, prefixTestChecks("0", checks)...), true
+}
+
+// testMapValue returns a map holding a single element under the "key" key.
+func testMapValue(e specschema.ElementType, updated bool) (string, []*TestCheck, bool) {
+	value, checks, ok := testElementTypeValue(e, updated)
+	if !ok {
+		return "", nil, false
+	}
+
+	return "{ key = " + value + " }", append([]*TestCheck{{Key: "%", Value: `"1"`}}, prefixTestChecks("key", checks)...), true
+}
+
+// testElementTypeValue returns the value of an element of a collection. Checks of primitive elements have an empty key.
+func testElementTypeValue(e specschema.ElementType, updated bool) (string, []*TestCheck, bool) {
+	switch {
+	case e.List != nil:
+		return testCollectionValue(e.List.ElementType, updated, true)
+	case e.Set != nil:
+		return testCollectionValue(e.Set.ElementType, updated, false)
+	case e.Map != nil:
+		return testMapValue(e.Map.ElementType, updated)
+	case e.Object != nil:
+		return testObjectValue(e.Object.AttributeTypes, updated)
+	}
+
+	value, expected := testElementValue(e, updated, nil)
+	if expected == "" {
+		return "", nil, false
+	}
+
+	return value, []*TestCheck{{Value: expected}}, true
+}
+
+// testObjectValue returns an object setting every attribute type.
+func testObjectValue(types specschema.ObjectAttributeTypes, updated bool) (string, []*TestCheck, bool) {
+	var (
+		fields []string
+		checks []*TestCheck
+	)
+
+	for _, t := range types {
+		value, elementChecks, ok := testElementTypeValue(specschema.ElementType{
+			Bool:    t.Bool,
+			Float32: t.Float32,
+			Float64: t.Float64,
+			Int32:   t.Int32,
+			Int64:   t.Int64,
+			List:    t.List,
+			Map:     t.Map,
+			Number:  t.Number,
+			Object:  t.Object,
+			Set:     t.Set,
+			String:  t.String,
+		}, updated)
+		if !ok {
+			return "", nil, false
+		}
+
+		fields = append(fields, t.Name+" = "+value)
+		checks = append(checks, prefixTestChecks(t.Name, elementChecks)...)
+	}
+
+	return "{ " + strings.Join(fields, ", ") + " }", checks, true
+}
+
+// testNestedValue returns an object setting every configurable nested attribute.
+func testNestedValue(attributes resource.Attributes, updated bool) (string, []*TestCheck, bool) {
+	var (
+		fields []string
+		checks []*TestCheck
+	)
+
+	for _, attr := range attributes {
+		if !isConfigurable(attr) {
+			continue
+		}
+
+		value, attrChecks, ok := testValue(attr, updated, nil)
+		if !ok {
+			if attributeBehavior(attr) == specschema.Required {
+				return "", nil, false
+			}
+			continue
+		}
+
+		fields = append(fields, attr.Name+" = "+value)
+		checks = append(checks, attrChecks...)
+	}
+
+	return "{ " + strings.Join(fields, ", ") + " }", checks, true
+}
+
+// testPrimitiveValue returns the HCL value and the expected state value of an attribute holding a primitive value.
+// One of values of the validators come first, then the default, the example, and generated values within the validated bounds.
+func testPrimitiveValue(attr resource.Attribute, updated bool, example any) (string, string, bool) {
+	switch {
+	case attr.String != nil:
+		v := parseTestValidators(attr.String.Validators.CustomValidators())
+		if value, ok := v.oneOf(updated); ok {
+			s, err := strconv.Unquote(value)
+			if quoted, expected, valid := hclString(s); err == nil && valid {
+				return quoted, expected, true
+			}
+		}
+		if !updated && attr.String.Default != nil && attr.String.Default.Static != nil {
+			if quoted, expected, ok := hclString(*attr.String.Default.Static); ok {
+				return quoted, expected, true
+			}
+		}
+		if s, ok := example.(string); ok && !updated {
+			if quoted, expected, valid := hclString(s); valid && v.allowsLength(len(s)) {
+				return quoted, expected, true
+			}
+		}
+		value, expected := generatedString(updated, v.min, v.max)
+		return value, expected, true
+	case attr.Bool != nil:
+		initial := true
+		if attr.Bool.Default != nil && attr.Bool.Default.Static != nil {
+			initial = *attr.Bool.Default.Static
+		} else if b, ok := example.(bool); ok {
+			initial = b
+		}
+		value := strconv.FormatBool(initial != updated)
+		return value, strconv.Quote(value), true
+	case attr.Int32 != nil:
+		var initial *float64
+		if attr.Int32.Default != nil && attr.Int32.Default.Static != nil {
+			initial = float64Ptr(float64(*attr.Int32.Default.Static))
+		}
+		value := testNumber(parseTestValidators(attr.Int32.Validators.CustomValidators()), initial, example, updated, true)
+		return value, strconv.Quote(value), true
+	case attr.Int64 != nil:
+		var initial *float64
+		if attr.Int64.Default != nil && attr.Int64.Default.Static != nil {
+			initial = float64Ptr(float64(*attr.Int64.Default.Static))
+		}
+		value := testNumber(parseTestValidators(attr.Int64.Validators.CustomValidators()), initial, example, updated, true)
+		return value, strconv.Quote(value), true
+	case attr.Float32 != nil:
+		var initial *float64
+		if attr.Float32.Default != nil && attr.Float32.Default.Static != nil {
+			initial = float64Ptr(float64(*attr.Float32.Default.Static))
+		}
+		value := testNumber(parseTestValidators(attr.Float32.Validators.CustomValidators()), initial, example, updated, false)
+		return value, strconv.Quote(value), true
+	case attr.Float64 != nil:
+		var initial *float64
+		if attr.Float64.Default != nil && attr.Float64.Default.Static != nil {
+			initial = float64Ptr(*attr.Float64.Default.Static)
+		}
+		value := testNumber(parseTestValidators(attr.Float64.Validators.CustomValidators()), initial, example, updated, false)
+		return value, strconv.Quote(value), true
+	case attr.Number != nil:
+		value := testNumber(parseTestValidators(attr.Number.Validators.CustomValidators()), nil, example, updated, false)
+		return value, strconv.Quote(value), true
+	}
+
+	return "", "", false
+}
+
+// testElementValue returns the HCL value and the Go expression of the expected state value for a primitive type.
+// The expected value is empty for types which are not supported.
+func testElementValue(e specschema.ElementType, updated bool, example any) (string, string) {
+	switch {
+	case e.String != nil:
+		if s, ok := example.(string); ok && !updated {
+			if quoted, expected, valid := hclString(s); valid {
+				return quoted, expected
+			}
+		}
+		return generatedString(updated, 0, 0)
+	case e.Bool != nil:
+		initial := true
+		if b, ok := example.(bool); ok {
+			initial = b
+		}
+		value := strconv.FormatBool(initial != updated)
+		return value, strconv.Quote(value)
+	case e.Int32 != nil, e.Int64 != nil:
+		value := testNumber(testValidators{}, nil, example, updated, true)
+		return value, strconv.Quote(value)
+	case e.Float32 != nil, e.Float64 != nil, e.Number != nil:
+		value := testNumber(testValidators{}, nil, example, updated, false)
+		return value, strconv.Quote(value)
+	}
+
+	return "", ""
+}
+
+// isExampleString reports whether the example can be written as a string value.
+func isExampleString(example any) bool {
+	s, ok := example.(string)
+	if !ok {
+		return false
+	}
+
+	_, _, valid := hclString(s)
+	return valid
+}
+
+// hclString returns the HCL literal of s within the fmt.Sprintf raw string of the generated test, and its Go literal.
+// It reports false for strings which can not be written in a raw string literal.
+func hclString(s string) (string, string, bool) {
+	if strings.ContainsAny(s, "`\n") {
+		return "", "", false
+	}
+
+	quoted := strconv.Quote(s)
+	value := strings.NewReplacer("%{", "%%%%{", "${", "$${", "%", "%%").Replace(quoted)
+
+	return value, quoted, true
+}
+
+// generatedString returns a string built from the random suffix, within the length bounds when they are set.
+func generatedString(updated bool, min, max int) (string, string) {
+	tail := ""
+	if updated {
+		tail = "-upd"
+	}
+
+	length := len("tf-") + testSuffixLength + len(tail)
+
+	if max > 0 && max < length {
+		c := "a"
+		if updated {
+			c = "b"
+		}
+		s := strconv.Quote(strings.Repeat(c, max))
+		return s, s
+	}
+
+	if min > length {
+		tail += strings.Repeat("x", min-length)
+	}
+
+	if tail == "" {
+		return `"tf-%[1]s"`, `"tf-" + suffix`
+	}
+
+	return `"tf-%[1]s` + tail + `"`, `"tf-" + suffix + "` + tail + `"`
+}
+
+// testNumber returns a number within the validated bounds. The initial value is the first one of values,
+// the default, the example or the lower bound, and the updated value follows it when the bounds allow.
+func testNumber(v testValidators, initial *float64, example any, updated, integer bool) string {
+	format := func(f float64) string {
+		if integer {
+			return strconv.FormatInt(int64(f), 10)
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	if value, ok := v.oneOf(updated); ok {
+		return value
+	}
+
+	if initial == nil {
+		if f, ok := example.(float64); ok && (!integer || f == math.Trunc(f)) && v.allowsNumber(f) {
+			initial = &f
+		}
+	}
+
+	if initial == nil {
+		f := 1.0
+		if !integer {
+			f = 1.5
+		}
+		if v.lower != nil && f < *v.lower {
+			f = *v.lower
+		}
+		if v.upper != nil && f > *v.upper {
+			f = *v.upper
+		}
+		initial = &f
+	}
+
+	if !updated {
+		return format(*initial)
+	}
+
+	next := *initial + 1
+	if v.upper != nil && next > *v.upper {
+		next = *initial - 1
+		if v.lower != nil && next < *v.lower {
+			next = *initial
+		}
+	}
+
+	return format(next)
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+// testValidators are the constraints of the framework validators of an attribute which generated values must satisfy.
+type testValidators struct {
+	// values are the Go literals accepted by a OneOf validator.
+	values []string
+
+	// min and max bound the length of strings. Zero means no bound.
+	min, max int
+
+	// lower and upper bound numbers.
+	lower, upper *float64
+}
+
+// parseTestValidators reads the bounds from the schema definitions of the validators, e.g. stringvalidator.OneOf("A", "B").
+// Definitions which are not calls of the validators of terraform-plugin-framework-validators are ignored.
+func parseTestValidators(validators specschema.CustomValidators) testValidators {
+	var v testValidators
+
+	for _, validator := range validators {
+		if validator == nil {
+			continue
+		}
+
+		expr, err := parser.ParseExpr(validator.SchemaDefinition)
+		if err != nil {
+			continue
+		}
+
+		call, ok := expr.(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			continue
+		}
+
+		var args []string
+		for _, arg := range call.Args {
+			if lit, ok := literalValue(arg); ok {
+				args = append(args, lit)
+			}
+		}
+
+		if len(args) != len(call.Args) {
+			continue
+		}
+
+		switch sel.Sel.Name {
+		case "OneOf":
+			v.values = args
+		case "LengthBetween":
+			if len(args) == 2 {
+				v.min, _ = strconv.Atoi(args[0])
+				v.max, _ = strconv.Atoi(args[1])
+			}
+		case "LengthAtLeast":
+			if len(args) == 1 {
+				v.min, _ = strconv.Atoi(args[0])
+			}
+		case "LengthAtMost":
+			if len(args) == 1 {
+				v.max, _ = strconv.Atoi(args[0])
+			}
+		case "Between":
+			if len(args) == 2 {
+				v.lower, v.upper = parseFloat(args[0]), parseFloat(args[1])
+			}
+		case "AtLeast":
+			if len(args) == 1 {
+				v.lower = parseFloat(args[0])
+			}
+		case "AtMost":
+			if len(args) == 1 {
+				v.upper = parseFloat(args[0])
+			}
+		}
+	}
+
+	return v
+}
+
+// literalValue returns the Go literal of a basic literal argument, allowing negative numbers.
+func literalValue(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return e.Value, true
+	case *ast.UnaryExpr:
+		if lit, ok := e.X.(*ast.BasicLit); ok && e.Op == token.SUB {
+			return "-" + lit.Value, true
+		}
+	}
+
+	return "", false
+}
+
+func parseFloat(s string) *float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &f
+}
+
+// oneOf returns the accepted value to use, the second one for updates when there are several.
+func (v testValidators) oneOf(updated bool) (string, bool) {
+	switch {
+	case len(v.values) == 0:
+		return "", false
+	case updated && len(v.values) > 1:
+		return v.values[1], true
+	default:
+		return v.values[0], true
+	}
+}
+
+func (v testValidators) allowsLength(n int) bool {
+	return n >= v.min && (v.max == 0 || n <= v.max)
+}
+
+func (v testValidators) allowsNumber(f float64) bool {
+	return (v.lower == nil || f >= *v.lower) && (v.upper == nil || f <= *v.upper)
+}
+
+// attributeBehavior returns whether the attribute is required, optional or computed.
+func attributeBehavior(attr resource.Attribute) specschema.ComputedOptionalRequired {
+	switch {
+	case attr.Bool != nil:
+		return attr.Bool.ComputedOptionalRequired
+	case attr.Dynamic != nil:
+		return attr.Dynamic.ComputedOptionalRequired
+	case attr.Float32 != nil:
+		return attr.Float32.ComputedOptionalRequired
+	case attr.Float64 != nil:
+		return attr.Float64.ComputedOptionalRequired
+	case attr.Int32 != nil:
+		return attr.Int32.ComputedOptionalRequired
+	case attr.Int64 != nil:
+		return attr.Int64.ComputedOptionalRequired
+	case attr.List != nil:
+		return attr.List.ComputedOptionalRequired
+	case attr.ListNested != nil:
+		return attr.ListNested.ComputedOptionalRequired
+	case attr.Map != nil:
+		return attr.Map.ComputedOptionalRequired
+	case attr.MapNested != nil:
+		return attr.MapNested.ComputedOptionalRequired
+	case attr.Number != nil:
+		return attr.Number.ComputedOptionalRequired
+	case attr.Object != nil:
+		return attr.Object.ComputedOptionalRequired
+	case attr.Set != nil:
+		return attr.Set.ComputedOptionalRequired
+	case attr.SetNested != nil:
+		return attr.SetNested.ComputedOptionalRequired
+	case attr.SingleNested != nil:
+		return attr.SingleNested.ComputedOptionalRequired
+	case attr.String != nil:
+		return attr.String.ComputedOptionalRequired
+	}
+
+	return ""
+}
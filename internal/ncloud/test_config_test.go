@@ -0,0 +1,281 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMakeTestSteps(t *testing.T) {
+	t.Parallel()
+
+	attributes := resource.Attributes{
+		{
+			Name:   "product_name",
+			String: &resource.StringAttribute{ComputedOptionalRequired: specschema.Required},
+		},
+		{
+			Name: "enabled",
+			Bool: &resource.BoolAttribute{ComputedOptionalRequired: specschema.Optional},
+		},
+		{
+			Name: "tags",
+			List: &resource.ListAttribute{
+				ComputedOptionalRequired: specschema.ComputedOptional,
+				ElementType:              specschema.ElementType{String: &specschema.StringType{}},
+			},
+		},
+		{
+			Name:   "product_id",
+			String: &resource.StringAttribute{ComputedOptionalRequired: specschema.Computed},
+		},
+	}
+
+	testCases := map[string]struct {
+		attributes     resource.Attributes
+		createOp       *Operation
+		updateOps      []*Operation
+		expectedCreate *TestStep
+		expectedUpdate *TestStep
+	}{
+		"no-update": {
+			attributes: attributes,
+			updateOps:  nil,
+			expectedCreate: &TestStep{
+				Config: "\t\tproduct_name = \"tf-%[1]s\"\n\t\tenabled = true\n\t\ttags = [\"tf-%[1]s\"]\n",
+				Checks: []*TestCheck{
+					{Key: "product_name", Value: `"tf-" + suffix`},
+					{Key: "enabled", Value: `"true"`},
+					{Key: "tags.#", Value: `"1"`},
+					{Key: "tags.0", Value: `"tf-" + suffix`},
+				},
+			},
+		},
+		"update": {
+			attributes: attributes,
+			updateOps: []*Operation{
+				{
+					Fields: []*FieldMapping{
+						{Name: "productId", Location: ParameterLocationPath},
+						{Name: "enabled", Location: ParameterLocationBody},
+					},
+				},
+			},
+			expectedCreate: &TestStep{
+				Config: "\t\tproduct_name = \"tf-%[1]s\"\n\t\tenabled = true\n\t\ttags = [\"tf-%[1]s\"]\n",
+				Checks: []*TestCheck{
+					{Key: "product_name", Value: `"tf-" + suffix`},
+					{Key: "enabled", Value: `"true"`},
+					{Key: "tags.#", Value: `"1"`},
+					{Key: "tags.0", Value: `"tf-" + suffix`},
+				},
+			},
+			expectedUpdate: &TestStep{
+				Config: "\t\tproduct_name = \"tf-%[1]s\"\n\t\tenabled = false\n\t\ttags = [\"tf-%[1]s\"]\n",
+				Checks: []*TestCheck{
+					{Key: "product_name", Value: `"tf-" + suffix`},
+					{Key: "enabled", Value: `"false"`},
+					{Key: "tags.#", Value: `"1"`},
+					{Key: "tags.0", Value: `"tf-" + suffix`},
+				},
+			},
+		},
+		"validators": {
+			attributes: resource.Attributes{
+				{
+					Name: "type",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: specschema.Required,
+						Validators: specschema.StringValidators{
+							{Custom: &specschema.CustomValidator{SchemaDefinition: `stringvalidator.OneOf("PUBLIC", "PRIVATE")`}},
+						},
+					},
+				},
+				{
+					Name: "code",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: specschema.Required,
+						Validators: specschema.StringValidators{
+							{Custom: &specschema.CustomValidator{SchemaDefinition: "stringvalidator.LengthBetween(1, 4)"}},
+						},
+					},
+				},
+				{
+					Name: "rate",
+					Int64: &resource.Int64Attribute{
+						ComputedOptionalRequired: specschema.Optional,
+						Validators: specschema.Int64Validators{
+							{Custom: &specschema.CustomValidator{SchemaDefinition: "int64validator.Between(10, 10)"}},
+						},
+					},
+				},
+			},
+			updateOps: []*Operation{
+				{
+					Fields: []*FieldMapping{
+						{Name: "type", Location: ParameterLocationBody},
+						{Name: "code", Location: ParameterLocationBody},
+						{Name: "rate", Location: ParameterLocationBody},
+					},
+				},
+			},
+			expectedCreate: &TestStep{
+				Config: "\t\ttype = \"PUBLIC\"\n\t\tcode = \"aaaa\"\n\t\trate = 10\n",
+				Checks: []*TestCheck{
+					{Key: "type", Value: `"PUBLIC"`},
+					{Key: "code", Value: `"aaaa"`},
+					{Key: "rate", Value: `"10"`},
+				},
+			},
+			expectedUpdate: &TestStep{
+				Config: "\t\ttype = \"PRIVATE\"\n\t\tcode = \"bbbb\"\n\t\trate = 10\n",
+				Checks: []*TestCheck{
+					{Key: "type", Value: `"PRIVATE"`},
+					{Key: "code", Value: `"bbbb"`},
+					{Key: "rate", Value: `"10"`},
+				},
+			},
+		},
+		"defaults-and-examples": {
+			attributes: resource.Attributes{
+				{
+					Name: "enabled",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: specschema.ComputedOptional,
+						Default:                  &specschema.BoolDefault{Static: pointer(false)},
+					},
+				},
+				{
+					Name:   "endpoint",
+					String: &resource.StringAttribute{ComputedOptionalRequired: specschema.Required},
+				},
+				{
+					Name:  "port",
+					Int32: &resource.Int32Attribute{ComputedOptionalRequired: specschema.Required},
+				},
+			},
+			createOp: &Operation{
+				Fields: []*FieldMapping{
+					{Name: "endpoint", Example: "https://100%.example.com", Location: ParameterLocationBody},
+					{Name: "port", Example: float64(8080), Location: ParameterLocationBody},
+				},
+			},
+			expectedCreate: &TestStep{
+				Config: "\t\tenabled = false\n\t\tendpoint = \"https://100%%.example.com\"\n\t\tport = 8080\n",
+				Checks: []*TestCheck{
+					{Key: "enabled", Value: `"false"`},
+					{Key: "endpoint", Value: `"https://100%.example.com"`},
+					{Key: "port", Value: `"8080"`},
+				},
+			},
+		},
+		"nested": {
+			attributes: resource.Attributes{
+				{
+					Name: "rules",
+					ListNested: &resource.ListNestedAttribute{
+						ComputedOptionalRequired: specschema.Optional,
+						NestedObject: resource.NestedAttributeObject{
+							Attributes: resource.Attributes{
+								{
+									Name:   "name",
+									String: &resource.StringAttribute{ComputedOptionalRequired: specschema.Required},
+								},
+								{
+									Name:  "priority",
+									Int64: &resource.Int64Attribute{ComputedOptionalRequired: specschema.Optional},
+								},
+								{
+									Name:   "rule_id",
+									String: &resource.StringAttribute{ComputedOptionalRequired: specschema.Computed},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "labels",
+					Map: &resource.MapAttribute{
+						ComputedOptionalRequired: specschema.Optional,
+						ElementType:              specschema.ElementType{Float64: &specschema.Float64Type{}},
+					},
+				},
+			},
+			expectedCreate: &TestStep{
+				Config: "\t\trules = [{ name = \"tf-%[1]s\", priority = 1 }]\n\t\tlabels = { key = 1.5 }\n",
+				Checks: []*TestCheck{
+					{Key: "rules.#", Value: `"1"`},
+					{Key: "rules.0.name", Value: `"tf-" + suffix`},
+					{Key: "rules.0.priority", Value: `"1"`},
+					{Key: "labels.%", Value: `"1"`},
+					{Key: "labels.key", Value: `"1.5"`},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			create, update := MakeTestSteps(testCase.attributes, testCase.createOp, testCase.updateOps)
+
+			if diff := cmp.Diff(create, testCase.expectedCreate); diff != "" {
+				t.Errorf("unexpected create step difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(update, testCase.expectedUpdate); diff != "" {
+				t.Errorf("unexpected update step difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMakeDataSourceTestTFConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params   *util.RequestParameters
+		expected string
+	}{
+		"nil": {
+			params:   nil,
+			expected: "",
+		},
+		"types": {
+			params: &util.RequestParameters{
+				Required: []*util.RequestParametersInfo{
+					{Name: "productId", Type: "string"},
+					{Name: "region", Type: "string", Example: "KR"},
+					{Name: "pageSize", Type: "integer"},
+					{Name: "isPublic", Type: "boolean"},
+					{Name: "tags", Type: "array"},
+				},
+			},
+			expected: "\t\tproduct_id = \"%[1]s\"\n\t\tregion = \"KR\"\n\t\tpage_size = 1\n\t\tis_public = true\n\t\ttags = []\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MakeDataSourceTestTFConfig(testCase.params)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func pointer[T any](in T) *T {
+	return &in
+}
@@ -0,0 +1,133 @@
+package ncloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+)
+
+// WaitStates are the status values an asynchronous operation goes through until it completes.
+type WaitStates struct {
+	Pending []string
+	Target  []string
+	Failure []string
+}
+
+// Wait describes how generated resources wait for create, update and delete operations to complete.
+// When StatusGetter is empty, the state is decided by whether the remote object can be read.
+type Wait struct {
+	// StatusGetter is the expression reading the status from the read response.
+	StatusGetter string
+
+	Create *WaitStates
+	Update *WaitStates
+	Delete *WaitStates
+}
+
+// NewWait builds the wait information of a resource from the wait field of the specification.
+func NewWait(c *util.WaitConfig, resourceName string) (*Wait, error) {
+	w := &Wait{}
+
+	if c == nil {
+		return w, nil
+	}
+
+	if c.StatusPath == "" {
+		return nil, fmt.Errorf("wait: status_path is required")
+	}
+
+	w.StatusGetter = makeResponseValueGetter(c.StatusPath, resourceName)
+
+	var err error
+
+	if w.Create, err = newWaitStates(c.Create); err != nil {
+		return nil, fmt.Errorf("wait.create: %w", err)
+	}
+
+	if w.Update, err = newWaitStates(c.Update); err != nil {
+		return nil, fmt.Errorf("wait.update: %w", err)
+	}
+
+	if w.Delete, err = newWaitStates(c.Delete); err != nil {
+		return nil, fmt.Errorf("wait.delete: %w", err)
+	}
+
+	return w, nil
+}
+
+func newWaitStates(s *util.WaitStates) (*WaitStates, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	if len(s.Target) == 0 {
+		return nil, fmt.Errorf("target is required")
+	}
+
+	return &WaitStates{
+		Pending: s.Pending,
+		Target:  s.Target,
+		Failure: s.Failure,
+	}, nil
+}
+
+// Timeouts holds the Go expression of the default timeout of each operation.
+// Resources with Timeouts declare a timeouts block, which users can override in their configuration.
+type Timeouts struct {
+	Create string
+	Update string
+	Delete string
+}
+
+// NewTimeouts builds the default timeouts of a resource from the timeouts field of the specification.
+// Operations without a default fall back to conn.DefaultTimeout. Nil is returned when the field is absent.
+func NewTimeouts(c *util.Timeouts) (*Timeouts, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	var err error
+	t := &Timeouts{}
+
+	if t.Create, err = durationExpr(c.Create); err != nil {
+		return nil, fmt.Errorf("timeouts.create: %w", err)
+	}
+
+	if t.Update, err = durationExpr(c.Update); err != nil {
+		return nil, fmt.Errorf("timeouts.update: %w", err)
+	}
+
+	if t.Delete, err = durationExpr(c.Delete); err != nil {
+		return nil, fmt.Errorf("timeouts.delete: %w", err)
+	}
+
+	return t, nil
+}
+
+// durationExpr returns the Go expression of the duration, e.g. 30 * time.Minute for "30m".
+func durationExpr(s string) (string, error) {
+	if s == "" {
+		return "conn.DefaultTimeout", nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return "", err
+	}
+
+	if d <= 0 {
+		return "", fmt.Errorf("duration must be positive, got %s", s)
+	}
+
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour), nil
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute), nil
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second), nil
+	default:
+		return fmt.Sprintf("time.Duration(%d)", d), nil
+	}
+}
@@ -0,0 +1,137 @@
+package ncloud
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewWait(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config        *util.WaitConfig
+		expected      *Wait
+		expectedError bool
+	}{
+		"nil": {
+			config:   nil,
+			expected: &Wait{},
+		},
+		"status": {
+			config: &util.WaitConfig{
+				StatusPath: "server.server_status",
+				Create:     &util.WaitStates{Pending: []string{"INIT"}, Target: []string{"RUN"}, Failure: []string{"ERROR"}},
+				Delete:     &util.WaitStates{Target: []string{"TERMT"}},
+			},
+			expected: &Wait{
+				StatusGetter: `ncloudsdk.ValueString(ncloudsdk.AttributeAt(response.Server, "server", "server_status"))`,
+				Create:       &WaitStates{Pending: []string{"INIT"}, Target: []string{"RUN"}, Failure: []string{"ERROR"}},
+				Delete:       &WaitStates{Target: []string{"TERMT"}},
+			},
+		},
+		"missing-status-path": {
+			config: &util.WaitConfig{
+				Create: &util.WaitStates{Target: []string{"RUN"}},
+			},
+			expectedError: true,
+		},
+		"missing-target": {
+			config: &util.WaitConfig{
+				StatusPath: "server.server_status",
+				Update:     &util.WaitStates{Pending: []string{"SETTING"}},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewWait(testCase.config, "server")
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewTimeouts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config        *util.Timeouts
+		expected      *Timeouts
+		expectedError bool
+	}{
+		"nil": {
+			config:   nil,
+			expected: nil,
+		},
+		"defaults": {
+			config: &util.Timeouts{Create: "1h", Update: "90s", Delete: "1500ms"},
+			expected: &Timeouts{
+				Create: "1 * time.Hour",
+				Update: "90 * time.Second",
+				Delete: "time.Duration(1500000000)",
+			},
+		},
+		"fallback": {
+			config: &util.Timeouts{Create: "30m"},
+			expected: &Timeouts{
+				Create: "30 * time.Minute",
+				Update: "conn.DefaultTimeout",
+				Delete: "conn.DefaultTimeout",
+			},
+		},
+		"invalid": {
+			config:        &util.Timeouts{Delete: "ten minutes"},
+			expectedError: true,
+		},
+		"negative": {
+			config:        &util.Timeouts{Create: "-5m"},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewTimeouts(testCase.config)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -3,301 +3,392 @@ package ncloud
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/format"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/code"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/spec"
 )
 
-func WriteNcloudResources(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName string, genRefresh bool) error {
+// WriteNcloudResources writes the schema and CRUD logic of every resource.
+// A resource failing to render does not stop the others; errors are returned together, prefixed with the resource name.
+func WriteNcloudResources(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName, sdkImportPath string) error {
+	var errs []error
+
 	for k, v := range resourcesSchema {
 		dirName := ""
 
 		if packageName == "" {
 			dirName = k
-
-			err := os.MkdirAll(filepath.Join(outputDir, dirName), os.ModePerm)
-			if err != nil {
-				return err
-			}
 		}
 
 		filename := fmt.Sprintf("%s.go", k)
 
-		n := NewResource(spec, k, packageName)
-
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(v)
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderInitial())
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderImportState())
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderCreate())
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderRead())
+		n, err := NewResource(spec, k, packageName)
 		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("resource %s: %w", k, err))
+			continue
 		}
 
-		_, err = f.Write(n.RenderUpdate())
-		if err != nil {
-			return err
-		}
+		renders := append([]func() ([]byte, error){bytesRenderer(v)}, CodeRenders(n)...)
 
-		_, err = f.Write(n.RenderDelete())
+		err = writeNcloudFile(filepath.Join(outputDir, dirName, filename), sdkImportPath, renders...)
 		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderModel())
-		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("resource %s: %w", k, err))
 		}
-
-		filePath := f.Name()
-
-		util.RemoveDuplicates(filePath)
-		util.RemoveCustomType(filePath)
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // WriteDataSources uses the packageName to determine whether to create a directory and package per data source.
 // If packageName is an empty string, this indicates that the flag was not set, and the default behaviour is
 // then to create a package and directory per data source. If packageName is set then all generated code is
 // placed into the same directory and package.
-func WriteNcloudDataSources(dataSourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName string) error {
+func WriteNcloudDataSources(dataSourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName, sdkImportPath string) error {
+	var errs []error
+
 	for k, v := range dataSourcesSchema {
 		dirName := ""
 
 		if packageName == "" {
-			dirName = k
-
-			err := os.MkdirAll(filepath.Join(outputDir, dirName), os.ModePerm)
-			if err != nil {
-				return err
-			}
+			dirName = fmt.Sprintf("%s_data_source", k)
 		}
 
 		filename := fmt.Sprintf("%s_data_source.go", k)
 
-		n := NewDataSources(&spec, k, packageName)
-
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
+		n, err := NewDataSources(&spec, k, packageName)
 		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(v)
-		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("data source %s: %w", k, err))
+			continue
 		}
 
 		// --- NCLOUD Logic ---
-		_, err = f.Write(n.RenderInitial())
-		if err != nil {
-			return err
-		}
+		renders := append([]func() ([]byte, error){bytesRenderer(v)}, CodeRenders(n)...)
 
-		_, err = f.Write(n.RenderRead())
+		err = writeNcloudFile(filepath.Join(outputDir, dirName, filename), sdkImportPath, renders...)
 		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderModel())
-		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("data source %s: %w", k, err))
 		}
-
-		filePath := f.Name()
-
-		util.RemoveDuplicates(filePath)
-		util.RemoveCustomType(filePath)
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // WriteDataSources uses the packageName to determine whether to create a directory and package per data source.
 // If packageName is an empty string, this indicates that the flag was not set, and the default behaviour is
 // then to create a package and directory per data source. If packageName is set then all generated code is
 // placed into the same directory and package.
-func WriteNcloudDataSourceTests(dataSourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName string) error {
+func WriteNcloudDataSourceTests(dataSourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName, sdkImportPath string) error {
+	var errs []error
+
 	for k := range dataSourcesSchema {
 		dirName := ""
 
 		if packageName == "" {
 			dirName = fmt.Sprintf("%s_data_source", k)
-
-			err := os.MkdirAll(filepath.Join(outputDir, dirName), os.ModePerm)
-			if err != nil {
-				return err
-			}
 		}
 
 		filename := fmt.Sprintf("%s_data_source_test.go", k)
 
-		n := NewDataSources(&spec, k, packageName)
-
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
+		n, err := NewDataSources(&spec, k, packageName)
 		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("data source %s: %w", k, err))
+			continue
 		}
 
-		_, err = f.Write(n.RenderTest())
+		err = writeNcloudFile(filepath.Join(outputDir, dirName, filename), sdkImportPath, n.RenderTest)
 		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("data source %s: %w", k, err))
 		}
-
-		filePath := f.Name()
-
-		util.RemoveDuplicates(filePath)
-		util.RemoveCustomType(filePath)
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // WriteResources uses the packageName to determine whether to create a directory and package per resource.
 // If packageName is an empty string, this indicates that the flag was not set, and the default behaviour is
 // then to create a package and directory per resource. If packageName is set then all generated code is
 // placed into the same directory and package.
-func WriteNcloudResourceTests(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName string) error {
+func WriteNcloudResourceTests(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName, sdkImportPath string) error {
+	var errs []error
+
 	for k := range resourcesSchema {
 		dirName := ""
 
 		if packageName == "" {
 			dirName = k
-
-			err := os.MkdirAll(filepath.Join(outputDir, dirName), os.ModePerm)
-			if err != nil {
-				return err
-			}
 		}
 
 		filename := fmt.Sprintf("%s_test.go", k)
 
-		n := NewResource(spec, k, packageName)
+		n, err := NewResource(spec, k, packageName)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("resource %s: %w", k, err))
+			continue
+		}
 
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
+		err = writeNcloudFile(filepath.Join(outputDir, dirName, filename), sdkImportPath, n.RenderTest)
 		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("resource %s: %w", k, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// WriteNcloudResourceMockTests writes, for every resource, unit tests running against an in-memory API server.
+// They live within the package of the resource, so they can register it in a test provider.
+func WriteNcloudResourceMockTests(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName, sdkImportPath string) error {
+	var errs []error
+
+	for k := range resourcesSchema {
+		dirName := ""
+
+		if packageName == "" {
+			dirName = k
 		}
 
-		_, err = f.Write(n.RenderTest())
+		filename := fmt.Sprintf("%s_mock_test.go", k)
+
+		n, err := NewResource(spec, k, packageName)
 		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("resource %s: %w", k, err))
+			continue
 		}
 
-		filePath := f.Name()
+		m, ok := n.(MockTester)
+		if !ok {
+			continue
+		}
 
-		util.RemoveDuplicates(filePath)
-		util.RemoveCustomType(filePath)
+		err = writeNcloudFile(filepath.Join(outputDir, dirName, filename), sdkImportPath, m.RenderMockTest)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("resource %s: %w", k, err))
+		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
-func WriteNcloudResourceRefresh(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName string) error {
+func WriteNcloudResourceRefresh(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName, sdkImportPath string) error {
+	var errs []error
+
 	for k := range resourcesSchema {
 		dirName := ""
 
 		if packageName == "" {
 			dirName = k
-
-			err := os.MkdirAll(filepath.Join(outputDir, dirName), os.ModePerm)
-			if err != nil {
-				return err
-			}
 		}
 
 		filename := fmt.Sprintf("%s_refresh.go", k)
 
-		n := NewResource(spec, k, packageName)
+		n, err := NewResource(spec, k, packageName)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("resource %s: %w", k, err))
+			continue
+		}
 
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
+		err = writeNcloudFile(filepath.Join(outputDir, dirName, filename), sdkImportPath, RefreshRenders(n)...)
 		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("resource %s: %w", k, err))
 		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func WriteNcloudDataSourceRefresh(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName, sdkImportPath string) error {
+	var errs []error
+
+	for k := range resourcesSchema {
+		dirName := ""
+
+		if packageName == "" {
+			dirName = fmt.Sprintf("%s_data_source", k)
+		}
+
+		filename := fmt.Sprintf("%s_refresh.go", k)
 
-		_, err = f.Write(n.RenderRefresh())
+		n, err := NewDataSources(&spec, k, packageName)
 		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("data source %s: %w", k, err))
+			continue
 		}
 
-		_, err = f.Write(n.RenderWait())
+		err = writeNcloudFile(filepath.Join(outputDir, dirName, filename), sdkImportPath, RefreshRenders(n)...)
 		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("data source %s: %w", k, err))
 		}
+	}
+
+	return errors.Join(errs...)
+}
 
-		filePath := f.Name()
+// CodeRenders returns the render functions of the main file, in order, according to the capabilities of the template.
+func CodeRenders(n BaseTemplate) []func() ([]byte, error) {
+	renders := []func() ([]byte, error){n.RenderInitial}
 
-		util.RemoveDuplicates(filePath)
-		util.RemoveCustomType(filePath)
+	if i, ok := n.(Importer); ok {
+		renders = append(renders, i.RenderImportState)
 	}
 
-	return nil
+	w, isWriter := n.(Writer)
+	if isWriter {
+		renders = append(renders, w.RenderCreate)
+	}
+
+	if r, ok := n.(Reader); ok {
+		renders = append(renders, r.RenderRead)
+	}
+
+	if isWriter {
+		renders = append(renders, w.RenderUpdate, w.RenderDelete)
+	}
+
+	return append(renders, n.RenderModel)
 }
 
-func WriteNcloudDataSourceRefresh(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName string) error {
-	for k := range resourcesSchema {
-		dirName := ""
+// RefreshRenders returns the render functions of the refresh file according to the capabilities of the template.
+func RefreshRenders(n BaseTemplate) []func() ([]byte, error) {
+	renders := []func() ([]byte, error){n.RenderRefresh}
 
-		if packageName == "" {
-			dirName = k
+	if w, ok := n.(Waiter); ok {
+		renders = append(renders, w.RenderWait)
+	}
+
+	return renders
+}
+
+// Render returns the output of every render function, in order, stopping at the first error.
+func Render(renders ...func() ([]byte, error)) ([][]byte, error) {
+	fragments := make([][]byte, 0, len(renders))
 
-			err := os.MkdirAll(filepath.Join(outputDir, dirName), os.ModePerm)
-			if err != nil {
-				return err
-			}
+	for _, render := range renders {
+		b, err := render()
+		if err != nil {
+			return nil, err
 		}
 
-		filename := fmt.Sprintf("%s_refresh.go", k)
+		fragments = append(fragments, b)
+	}
+
+	return fragments, nil
+}
+
+// AssembleFile renders the fragments of a file and assembles them into the formatted file,
+// importing the packages the code uses. sdkImportPath is the import path of the SDK; see SDKImportPath.
+func AssembleFile(sdkImportPath string, renders ...func() ([]byte, error)) ([]byte, error) {
+	fragments, err := Render(renders...)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := append(knownImports[:len(knownImports):len(knownImports)], code.Import{Path: sdkImportPath})
+
+	return format.Assemble(imports, fragments...)
+}
+
+// writeNcloudFile creates the file along with its directory and writes the code assembled from the rendered fragments.
+func writeNcloudFile(filePath, sdkImportPath string, renders ...func() ([]byte, error)) error {
+	b, err := AssembleFile(sdkImportPath, renders...)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, b, 0644)
+}
+
+func bytesRenderer(b []byte) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		return b, nil
+	}
+}
+
+// WriteNcloudSDK writes the client package called by generated resources and data sources into outputDir/packageName.
+func WriteNcloudSDK(spec util.NcloudSpecification, outputDir, packageName string) error {
+	n, err := NewSDK(spec, packageName)
+	if err != nil {
+		return err
+	}
 
-		n := NewDataSources(&spec, k, packageName)
+	files := make(map[string][]byte)
 
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
+	files["client.go"], err = n.RenderClient()
+	if err != nil {
+		return err
+	}
+
+	files["convert.go"], err = n.RenderConvert()
+	if err != nil {
+		return err
+	}
+
+	files["factory.go"], err = n.RenderFactory()
+	if err != nil {
+		return err
+	}
+
+	for _, op := range n.Operations() {
+		files[op.FileName()], err = n.RenderOperation(op)
 		if err != nil {
 			return err
 		}
+	}
+
+	formattedFiles, err := format.Format(files)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Join(outputDir, packageName), os.ModePerm)
+	if err != nil {
+		return err
+	}
 
-		_, err = f.Write(n.RenderRefresh())
+	for filename, v := range formattedFiles {
+		err = os.WriteFile(filepath.Join(outputDir, packageName, filename), v, 0644)
 		if err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
 
-		// TODO - Implement RenderWait() method
-		// _, err = f.Write(n.RenderWait())
-		// if err != nil {
-		// 	return err
-		// }
+// WriteNcloudRegistry writes the registry of every generated resource and data source into outputDir.
+// importPath is the import path of outputDir, detected from go.mod when empty; see NewRegistry.
+func WriteNcloudRegistry(spec util.NcloudSpecification, outputDir, packageName, importPath string) error {
+	n, err := NewRegistry(spec, outputDir, packageName, importPath)
+	if err != nil {
+		return err
+	}
 
-		filePath := f.Name()
+	b, err := n.Render()
+	if err != nil {
+		return err
+	}
 
-		util.RemoveDuplicates(filePath)
+	formattedFiles, err := format.Format(map[string][]byte{n.FileName(): b})
+	if err != nil {
+		return err
 	}
 
-	return nil
+	err = os.MkdirAll(outputDir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(outputDir, n.FileName()), formattedFiles[n.FileName()], 0644)
 }
 
 // Parse returns a Specification from the JSON document contents, or any validation errors.
@@ -0,0 +1,77 @@
+package ncloud
+
+import (
+	"bytes"
+	"testing"
+)
+
+type fakeTemplate struct{}
+
+func (fakeTemplate) RenderInitial() ([]byte, error) { return []byte("initial;"), nil }
+func (fakeTemplate) RenderModel() ([]byte, error)   { return []byte("model;"), nil }
+func (fakeTemplate) RenderRefresh() ([]byte, error) { return []byte("refresh;"), nil }
+func (fakeTemplate) RenderTest() ([]byte, error)    { return []byte("test;"), nil }
+
+type fakeReaderTemplate struct{ fakeTemplate }
+
+func (fakeReaderTemplate) RenderRead() ([]byte, error) { return []byte("read;"), nil }
+
+type fakeResourceTemplate struct{ fakeReaderTemplate }
+
+func (fakeResourceTemplate) RenderCreate() ([]byte, error)      { return []byte("create;"), nil }
+func (fakeResourceTemplate) RenderUpdate() ([]byte, error)      { return []byte("update;"), nil }
+func (fakeResourceTemplate) RenderDelete() ([]byte, error)      { return []byte("delete;"), nil }
+func (fakeResourceTemplate) RenderImportState() ([]byte, error) { return []byte("import;"), nil }
+func (fakeResourceTemplate) RenderWait() ([]byte, error)        { return []byte("wait;"), nil }
+
+func TestCodeRenders(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		template        BaseTemplate
+		expectedCode    string
+		expectedRefresh string
+	}{
+		"base": {
+			template:        fakeTemplate{},
+			expectedCode:    "initial;model;",
+			expectedRefresh: "refresh;",
+		},
+		"reader": {
+			template:        fakeReaderTemplate{},
+			expectedCode:    "initial;read;model;",
+			expectedRefresh: "refresh;",
+		},
+		"resource": {
+			template:        fakeResourceTemplate{},
+			expectedCode:    "initial;import;create;read;update;delete;model;",
+			expectedRefresh: "refresh;wait;",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			code, err := Render(CodeRenders(testCase.template)...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			refresh, err := Render(RefreshRenders(testCase.template)...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := string(bytes.Join(code, nil)); got != testCase.expectedCode {
+				t.Errorf("expected %s, got %s", testCase.expectedCode, got)
+			}
+
+			if got := string(bytes.Join(refresh, nil)); got != testCase.expectedRefresh {
+				t.Errorf("expected %s, got %s", testCase.expectedRefresh, got)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/format"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/ncloud"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/util"
 )
@@ -18,7 +19,7 @@ import (
 // If packageName is an empty string, this indicates that the flag was not set, and the default behaviour is
 // then to create a package and directory per data source. If packageName is set then all generated code is
 // placed into the same directory and package.
-func WriteDataSources(dataSourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName string) error {
+func WriteDataSources(dataSourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName, sdkImportPath string) error {
 	for k, v := range dataSourcesSchema {
 		dirName := ""
 
@@ -33,48 +34,23 @@ func WriteDataSources(dataSourcesSchema map[string][]byte, spec util.NcloudSpeci
 
 		filename := fmt.Sprintf("%s_data_source_gen.go", k)
 
-		n := ncloud.NewDataSources(&spec, k, packageName)
-
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(v)
+		n, err := ncloud.NewDataSources(&spec, k, packageName)
 		if err != nil {
-			return err
+			return fmt.Errorf("data source %s: %w", k, err)
 		}
 
 		// CORE - 이곳에 코드를 추가한다.
-		_, err = f.Write(n.RenderInitial())
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderRead())
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderModel())
-		if err != nil {
-			return err
-		}
+		schema := func() ([]byte, error) { return v, nil }
 
-		_, err = f.Write(n.RenderRefresh())
+		b, err := ncloud.AssembleFile(sdkImportPath, append(append([]func() ([]byte, error){schema}, ncloud.CodeRenders(n)...), ncloud.RefreshRenders(n)...)...)
 		if err != nil {
 			return err
 		}
 
-		_, err = f.Write(n.RenderWait())
+		err = os.WriteFile(filepath.Join(outputDir, dirName, filename), b, 0644)
 		if err != nil {
 			return err
 		}
-
-		filePath := f.Name()
-
-		util.RemoveDuplicates(filePath)
-		util.RemoveCustomType(filePath)
 	}
 
 	return nil
@@ -85,7 +61,7 @@ func WriteDataSources(dataSourcesSchema map[string][]byte, spec util.NcloudSpeci
 // then to create a package and directory per resource. If packageName is set then all generated code is
 // placed into the same directory and package.
 // CORE - 여기에 줄을 추가하여 생성하는 것으로 한다.
-func WriteResources(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName string) error {
+func WriteResources(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName, sdkImportPath string) error {
 	for k, v := range resourcesSchema {
 		dirName := ""
 
@@ -100,68 +76,23 @@ func WriteResources(resourcesSchema map[string][]byte, spec util.NcloudSpecifica
 
 		filename := fmt.Sprintf("%s_resource_gen.go", k)
 
-		n := ncloud.NewResource(spec, k, packageName)
-
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(v)
+		n, err := ncloud.NewResource(spec, k, packageName)
 		if err != nil {
-			return err
+			return fmt.Errorf("resource %s: %w", k, err)
 		}
 
 		// CORE - 이곳에 코드를 추가한다.
-		_, err = f.Write(n.RenderInitial())
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderImportState())
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderCreate())
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderRead())
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderUpdate())
-		if err != nil {
-			return err
-		}
+		schema := func() ([]byte, error) { return v, nil }
 
-		_, err = f.Write(n.RenderDelete())
+		b, err := ncloud.AssembleFile(sdkImportPath, append(append([]func() ([]byte, error){schema}, ncloud.CodeRenders(n)...), ncloud.RefreshRenders(n)...)...)
 		if err != nil {
 			return err
 		}
 
-		_, err = f.Write(n.RenderModel())
+		err = os.WriteFile(filepath.Join(outputDir, dirName, filename), b, 0644)
 		if err != nil {
 			return err
 		}
-
-		_, err = f.Write(n.RenderRefresh())
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(n.RenderWait())
-		if err != nil {
-			return err
-		}
-
-		filePath := f.Name()
-
-		util.RemoveDuplicates(filePath)
-		util.RemoveCustomType(filePath)
 	}
 
 	return nil
@@ -172,7 +103,7 @@ func WriteResources(resourcesSchema map[string][]byte, spec util.NcloudSpecifica
 // then to create a package and directory per resource. If packageName is set then all generated code is
 // placed into the same directory and package.
 // CORE - 여기에 줄을 추가하여 생성하는 것으로 한다.
-func WriteResourceTests(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName string) error {
+func WriteResourceTests(resourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName, sdkImportPath string) error {
 	for k := range resourcesSchema {
 		dirName := ""
 
@@ -187,23 +118,21 @@ func WriteResourceTests(resourcesSchema map[string][]byte, spec util.NcloudSpeci
 
 		filename := fmt.Sprintf("%s_resource_gen_test.go", k)
 
-		n := ncloud.NewResource(spec, k, packageName)
-
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
+		n, err := ncloud.NewResource(spec, k, packageName)
 		if err != nil {
-			return err
+			return fmt.Errorf("resource %s: %w", k, err)
 		}
 
 		// CORE - 이곳에 코드를 추가한다.
-		_, err = f.Write(n.RenderTest())
+		b, err := ncloud.AssembleFile(sdkImportPath, n.RenderTest)
 		if err != nil {
 			return err
 		}
 
-		filePath := f.Name()
-
-		util.RemoveDuplicates(filePath)
-		util.RemoveCustomType(filePath)
+		err = os.WriteFile(filepath.Join(outputDir, dirName, filename), b, 0644)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -213,7 +142,7 @@ func WriteResourceTests(resourcesSchema map[string][]byte, spec util.NcloudSpeci
 // If packageName is an empty string, this indicates that the flag was not set, and the default behaviour is
 // then to create a package and directory per data source. If packageName is set then all generated code is
 // placed into the same directory and package.
-func WriteDataSourceTests(dataSourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName string) error {
+func WriteDataSourceTests(dataSourcesSchema map[string][]byte, spec util.NcloudSpecification, outputDir, packageName, sdkImportPath string) error {
 	for k := range dataSourcesSchema {
 		dirName := ""
 
@@ -228,24 +157,22 @@ func WriteDataSourceTests(dataSourcesSchema map[string][]byte, spec util.NcloudS
 
 		filename := fmt.Sprintf("%s_data_source_gen_test.go", k)
 
-		n := ncloud.NewDataSources(&spec, k, packageName)
-
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
+		n, err := ncloud.NewDataSources(&spec, k, packageName)
 		if err != nil {
-			return err
+			return fmt.Errorf("data source %s: %w", k, err)
 		}
 
 		// TODO - Implement this method
 		// // CORE - 이곳에 코드를 추가한다.
-		_, err = f.Write(n.RenderTest())
+		b, err := ncloud.AssembleFile(sdkImportPath, n.RenderTest)
 		if err != nil {
 			return err
 		}
 
-		filePath := f.Name()
-
-		util.RemoveDuplicates(filePath)
-		util.RemoveCustomType(filePath)
+		err = os.WriteFile(filepath.Join(outputDir, dirName, filename), b, 0644)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -270,35 +197,15 @@ func WriteProviders(providersSchema, providerModels, customTypeValue, providerTo
 
 		filename := fmt.Sprintf("%s_provider_gen.go", k)
 
-		f, err := os.Create(filepath.Join(outputDir, dirName, filename))
+		b, err := format.Assemble(nil, v, providerModels[k], customTypeValue[k], providerToFrom[k])
 		if err != nil {
 			return err
 		}
 
-		_, err = f.Write(v)
+		err = os.WriteFile(filepath.Join(outputDir, dirName, filename), b, 0644)
 		if err != nil {
 			return err
 		}
-
-		_, err = f.Write(providerModels[k])
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(customTypeValue[k])
-		if err != nil {
-			return err
-		}
-
-		_, err = f.Write(providerToFrom[k])
-		if err != nil {
-			return err
-		}
-
-		filePath := f.Name()
-
-		util.RemoveDuplicates(filePath)
-		util.RemoveCustomType(filePath)
 	}
 
 	return nil
@@ -309,21 +216,10 @@ func WriteBytes(outputFilePath string, outputBytes []byte, forceOverwrite bool)
 		return fmt.Errorf("file (%s) already exists and --force is false", outputFilePath)
 	}
 
-	f, err := os.Create(outputFilePath)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-
-	_, err = f.Write(outputBytes)
+	b, err := format.Assemble(nil, outputBytes)
 	if err != nil {
 		return err
 	}
 
-	filePath := f.Name()
-
-	util.RemoveDuplicates(filePath)
-	util.RemoveCustomType(filePath)
-
-	return nil
+	return os.WriteFile(outputFilePath, b, 0644)
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/provider"
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/convert"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/model"
@@ -106,11 +107,11 @@ func (g GeneratorInt32Attribute) Equal(ga schema.GeneratorAttribute) bool {
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorInt32Attribute) Schema(name schema.FrameworkIdentifier) (string, error) {
+func (g GeneratorInt32Attribute) Schema(name schema.FrameworkIdentifier, options schema.SchemaOptions) (string, error) {
 	var b bytes.Buffer
 
 	b.WriteString(fmt.Sprintf("%q: schema.Int32Attribute{\n", name))
-	b.Write(g.CustomType.Schema())
+	b.Write(options.CustomTypeSchema(g.CustomType))
 	b.Write(g.OptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
 	b.Write(g.Description.Schema())
@@ -121,14 +122,14 @@ func (g GeneratorInt32Attribute) Schema(name schema.FrameworkIdentifier) (string
 	return b.String(), nil
 }
 
-func (g GeneratorInt32Attribute) ModelField(name schema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorInt32Attribute) ModelField(name schema.FrameworkIdentifier, options schema.SchemaOptions) (model.Field, error) {
 	field := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: model.Int32ValueType,
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		field.ValueType = customValueType
@@ -167,7 +168,7 @@ func (g GeneratorInt32Attribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorInt32Attribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorInt32Attribute) ToFromFunctions(name string, typeMapping schema.TypeMapping) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -210,6 +211,9 @@ func (g GeneratorInt32Attribute) To() (schema.ToFromConversion, error) {
 
 	return schema.ToFromConversion{
 		Default: "ValueInt32Pointer",
+		Type: &specschema.ElementType{
+			Int32: &specschema.Int32Type{},
+		},
 	}, nil
 }
 
@@ -222,5 +226,8 @@ func (g GeneratorInt32Attribute) From() (schema.ToFromConversion, error) {
 
 	return schema.ToFromConversion{
 		Default: "Int32PointerValue",
+		Type: &specschema.ElementType{
+			Int32: &specschema.Int32Type{},
+		},
 	}, nil
 }
@@ -129,10 +129,10 @@ func (g GeneratorMapAttribute) Equal(ga generatorschema.GeneratorAttribute) bool
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorMapAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
+func (g GeneratorMapAttribute) Schema(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (string, error) {
 	var b bytes.Buffer
 
-	customTypeSchema := g.CustomType.Schema()
+	customTypeSchema := options.CustomTypeSchema(g.CustomType)
 
 	b.WriteString(fmt.Sprintf("%q: schema.MapAttribute{\n", name))
 	b.Write(customTypeSchema)
@@ -149,14 +149,14 @@ func (g GeneratorMapAttribute) Schema(name generatorschema.FrameworkIdentifier)
 	return b.String(), nil
 }
 
-func (g GeneratorMapAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorMapAttribute) ModelField(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (model.Field, error) {
 	field := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: model.MapValueType,
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		field.ValueType = customValueType
@@ -197,7 +197,7 @@ func (g GeneratorMapAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorMapAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorMapAttribute) ToFromFunctions(name string, typeMapping generatorschema.TypeMapping) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -211,7 +211,7 @@ func (g GeneratorMapAttribute) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromMap(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromMap(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom, g.ElementType, typeMapping)
 
 	b, err := toFrom.Render()
 
@@ -253,6 +253,16 @@ func (g GeneratorMapAttribute) To() (generatorschema.ToFromConversion, error) {
 		}, nil
 	}
 
+	if generatorschema.HasNestedElementType(g.ElementType) {
+		return generatorschema.ToFromConversion{
+			Type: &specschema.ElementType{
+				Map: &specschema.MapType{
+					ElementType: g.ElementType,
+				},
+			},
+		}, nil
+	}
+
 	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType)
 
 	if err != nil {
@@ -260,6 +270,11 @@ func (g GeneratorMapAttribute) To() (generatorschema.ToFromConversion, error) {
 	}
 
 	return generatorschema.ToFromConversion{
+		Type: &specschema.ElementType{
+			Map: &specschema.MapType{
+				ElementType: g.ElementType,
+			},
+		},
 		CollectionType: generatorschema.CollectionFields{
 			GoType: fmt.Sprintf("map[string]%s", elementGoType),
 		},
@@ -273,6 +288,16 @@ func (g GeneratorMapAttribute) From() (generatorschema.ToFromConversion, error)
 		}, nil
 	}
 
+	if generatorschema.HasNestedElementType(g.ElementType) {
+		return generatorschema.ToFromConversion{
+			Type: &specschema.ElementType{
+				Map: &specschema.MapType{
+					ElementType: g.ElementType,
+				},
+			},
+		}, nil
+	}
+
 	elementType, err := generatorschema.ElementTypeString(g.ElementType)
 
 	if err != nil {
@@ -280,6 +305,11 @@ func (g GeneratorMapAttribute) From() (generatorschema.ToFromConversion, error)
 	}
 
 	return generatorschema.ToFromConversion{
+		Type: &specschema.ElementType{
+			Map: &specschema.MapType{
+				ElementType: g.ElementType,
+			},
+		},
 		CollectionType: generatorschema.CollectionFields{
 			ElementType:   elementType,
 			TypeValueFrom: "types.MapValueFrom",
@@ -129,10 +129,10 @@ func (g GeneratorSetAttribute) Equal(ga generatorschema.GeneratorAttribute) bool
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorSetAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
+func (g GeneratorSetAttribute) Schema(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (string, error) {
 	var b bytes.Buffer
 
-	customTypeSchema := g.CustomType.Schema()
+	customTypeSchema := options.CustomTypeSchema(g.CustomType)
 
 	b.WriteString(fmt.Sprintf("%q: schema.SetAttribute{\n", name))
 	b.Write(customTypeSchema)
@@ -149,14 +149,14 @@ func (g GeneratorSetAttribute) Schema(name generatorschema.FrameworkIdentifier)
 	return b.String(), nil
 }
 
-func (g GeneratorSetAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorSetAttribute) ModelField(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (model.Field, error) {
 	field := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: model.SetValueType,
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		field.ValueType = customValueType
@@ -197,7 +197,7 @@ func (g GeneratorSetAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSetAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorSetAttribute) ToFromFunctions(name string, typeMapping generatorschema.TypeMapping) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -211,7 +211,7 @@ func (g GeneratorSetAttribute) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromSet(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromSet(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom, g.ElementType, typeMapping)
 
 	b, err := toFrom.Render()
 
@@ -253,6 +253,16 @@ func (g GeneratorSetAttribute) To() (generatorschema.ToFromConversion, error) {
 		}, nil
 	}
 
+	if generatorschema.HasNestedElementType(g.ElementType) {
+		return generatorschema.ToFromConversion{
+			Type: &specschema.ElementType{
+				Set: &specschema.SetType{
+					ElementType: g.ElementType,
+				},
+			},
+		}, nil
+	}
+
 	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType)
 
 	if err != nil {
@@ -260,6 +270,11 @@ func (g GeneratorSetAttribute) To() (generatorschema.ToFromConversion, error) {
 	}
 
 	return generatorschema.ToFromConversion{
+		Type: &specschema.ElementType{
+			Set: &specschema.SetType{
+				ElementType: g.ElementType,
+			},
+		},
 		CollectionType: generatorschema.CollectionFields{
 			GoType: fmt.Sprintf("[]%s", elementGoType),
 		},
@@ -273,6 +288,16 @@ func (g GeneratorSetAttribute) From() (generatorschema.ToFromConversion, error)
 		}, nil
 	}
 
+	if generatorschema.HasNestedElementType(g.ElementType) {
+		return generatorschema.ToFromConversion{
+			Type: &specschema.ElementType{
+				Set: &specschema.SetType{
+					ElementType: g.ElementType,
+				},
+			},
+		}, nil
+	}
+
 	elementType, err := generatorschema.ElementTypeString(g.ElementType)
 
 	if err != nil {
@@ -280,6 +305,11 @@ func (g GeneratorSetAttribute) From() (generatorschema.ToFromConversion, error)
 	}
 
 	return generatorschema.ToFromConversion{
+		Type: &specschema.ElementType{
+			Set: &specschema.SetType{
+				ElementType: g.ElementType,
+			},
+		},
 		CollectionType: generatorschema.CollectionFields{
 			ElementType:   elementType,
 			TypeValueFrom: "types.SetValueFrom",
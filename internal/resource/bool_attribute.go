@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/convert"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/model"
@@ -126,11 +127,11 @@ func (g GeneratorBoolAttribute) Equal(ga generatorschema.GeneratorAttribute) boo
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorBoolAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
+func (g GeneratorBoolAttribute) Schema(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (string, error) {
 	var b bytes.Buffer
 
 	b.WriteString(fmt.Sprintf("%q: schema.BoolAttribute{\n", name))
-	b.Write(g.CustomType.Schema())
+	b.Write(options.CustomTypeSchema(g.CustomType))
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
 	b.Write(g.Description.Schema())
@@ -143,14 +144,14 @@ func (g GeneratorBoolAttribute) Schema(name generatorschema.FrameworkIdentifier)
 	return b.String(), nil
 }
 
-func (g GeneratorBoolAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorBoolAttribute) ModelField(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (model.Field, error) {
 	field := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: model.BoolValueType,
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		field.ValueType = customValueType
@@ -189,7 +190,7 @@ func (g GeneratorBoolAttribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorBoolAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorBoolAttribute) ToFromFunctions(name string, typeMapping generatorschema.TypeMapping) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -232,6 +233,9 @@ func (g GeneratorBoolAttribute) To() (generatorschema.ToFromConversion, error) {
 
 	return generatorschema.ToFromConversion{
 		Default: "ValueBoolPointer",
+		Type: &specschema.ElementType{
+			Bool: &specschema.BoolType{},
+		},
 	}, nil
 }
 
@@ -244,5 +248,8 @@ func (g GeneratorBoolAttribute) From() (generatorschema.ToFromConversion, error)
 
 	return generatorschema.ToFromConversion{
 		Default: "BoolPointerValue",
+		Type: &specschema.ElementType{
+			Bool: &specschema.BoolType{},
+		},
 	}, nil
 }
@@ -653,6 +653,7 @@ func TestGeneratorBoolAttribute_Schema(t *testing.T) {
 
 	testCases := map[string]struct {
 		input         GeneratorBoolAttribute
+		options       generatorschema.SchemaOptions
 		expected      string
 		expectedError error
 	}{
@@ -703,6 +704,25 @@ CustomType: my_custom_type,
 },`,
 		},
 
+		"associated-external-type-omitted": {
+			input: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					nil,
+					&specschema.AssociatedExternalType{
+						Type: "*api.ExtBool",
+					},
+					"bool_attribute",
+				),
+				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
+			},
+			options: generatorschema.SchemaOptions{
+				OmitCustomTypes: true,
+			},
+			expected: `"bool_attribute": schema.BoolAttribute{
+Required: true,
+},`,
+		},
+
 		"required": {
 			input: GeneratorBoolAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
@@ -828,7 +848,7 @@ Default: my_bool_default.Default(),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.Schema("bool_attribute")
+			got, err := testCase.input.Schema("bool_attribute", testCase.options)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -846,6 +866,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 
 	testCases := map[string]struct {
 		input         GeneratorBoolAttribute
+		options       generatorschema.SchemaOptions
 		expected      model.Field
 		expectedError error
 	}{
@@ -888,6 +909,25 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 				TfsdkName: "bool_attribute",
 			},
 		},
+		"associated-external-type-omitted": {
+			input: GeneratorBoolAttribute{
+				CustomType: convert.NewCustomTypePrimitive(
+					nil,
+					&specschema.AssociatedExternalType{
+						Type: "*api.BoolAttribute",
+					},
+					"bool_attribute",
+				),
+			},
+			options: generatorschema.SchemaOptions{
+				OmitCustomTypes: true,
+			},
+			expected: model.Field{
+				Name:      "BoolAttribute",
+				ValueType: "types.Bool",
+				TfsdkName: "bool_attribute",
+			},
+		},
 		"custom-type-overriding-associated-external-type": {
 			input: GeneratorBoolAttribute{
 				CustomType: convert.NewCustomTypePrimitive(
@@ -914,7 +954,7 @@ func TestGeneratorBoolAttribute_ModelField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.ModelField("bool_attribute")
+			got, err := testCase.input.ModelField("bool_attribute", testCase.options)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -86,6 +86,8 @@ func NewAttribute(a resource.Attribute) (generatorschema.GeneratorAttribute, err
 	switch {
 	case a.Bool != nil:
 		return NewGeneratorBoolAttribute(a.Name, a.Bool)
+	case a.Float32 != nil:
+		return NewGeneratorFloat32Attribute(a.Name, a.Float32)
 	case a.Float64 != nil:
 		return NewGeneratorFloat64Attribute(a.Name, a.Float64)
 	case a.Int64 != nil:
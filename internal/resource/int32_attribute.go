@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/convert"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-framework/internal/model"
@@ -126,11 +127,11 @@ func (g GeneratorInt32Attribute) Equal(ga generatorschema.GeneratorAttribute) bo
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorInt32Attribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
+func (g GeneratorInt32Attribute) Schema(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (string, error) {
 	var b bytes.Buffer
 
 	b.WriteString(fmt.Sprintf("%q: schema.Int32Attribute{\n", name))
-	b.Write(g.CustomType.Schema())
+	b.Write(options.CustomTypeSchema(g.CustomType))
 	b.Write(g.ComputedOptionalRequired.Schema())
 	b.Write(g.Sensitive.Schema())
 	b.Write(g.Description.Schema())
@@ -143,14 +144,14 @@ func (g GeneratorInt32Attribute) Schema(name generatorschema.FrameworkIdentifier
 	return b.String(), nil
 }
 
-func (g GeneratorInt32Attribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorInt32Attribute) ModelField(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (model.Field, error) {
 	field := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: model.Int32ValueType,
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		field.ValueType = customValueType
@@ -189,7 +190,7 @@ func (g GeneratorInt32Attribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorInt32Attribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorInt32Attribute) ToFromFunctions(name string, typeMapping generatorschema.TypeMapping) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -232,6 +233,9 @@ func (g GeneratorInt32Attribute) To() (generatorschema.ToFromConversion, error)
 
 	return generatorschema.ToFromConversion{
 		Default: "ValueInt32Pointer",
+		Type: &specschema.ElementType{
+			Int32: &specschema.Int32Type{},
+		},
 	}, nil
 }
 
@@ -244,5 +248,8 @@ func (g GeneratorInt32Attribute) From() (generatorschema.ToFromConversion, error
 
 	return generatorschema.ToFromConversion{
 		Default: "Int32PointerValue",
+		Type: &specschema.ElementType{
+			Int32: &specschema.Int32Type{},
+		},
 	}, nil
 }
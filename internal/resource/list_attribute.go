@@ -149,10 +149,10 @@ func (g GeneratorListAttribute) Equal(ga generatorschema.GeneratorAttribute) boo
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorListAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
+func (g GeneratorListAttribute) Schema(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (string, error) {
 	var b bytes.Buffer
 
-	customTypeSchema := g.CustomType.Schema()
+	customTypeSchema := options.CustomTypeSchema(g.CustomType)
 
 	b.WriteString(fmt.Sprintf("%q: schema.ListAttribute{\n", name))
 	b.Write(customTypeSchema)
@@ -171,14 +171,14 @@ func (g GeneratorListAttribute) Schema(name generatorschema.FrameworkIdentifier)
 	return b.String(), nil
 }
 
-func (g GeneratorListAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorListAttribute) ModelField(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (model.Field, error) {
 	field := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: model.ListValueType,
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		field.ValueType = customValueType
@@ -219,7 +219,7 @@ func (g GeneratorListAttribute) CustomTypeAndValue(name string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorListAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorListAttribute) ToFromFunctions(name string, typeMapping generatorschema.TypeMapping) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -233,7 +233,7 @@ func (g GeneratorListAttribute) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromList(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromList(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom, g.ElementType, typeMapping)
 
 	b, err := toFrom.Render()
 
@@ -275,6 +275,16 @@ func (g GeneratorListAttribute) To() (generatorschema.ToFromConversion, error) {
 		}, nil
 	}
 
+	if generatorschema.HasNestedElementType(g.ElementType) {
+		return generatorschema.ToFromConversion{
+			Type: &specschema.ElementType{
+				List: &specschema.ListType{
+					ElementType: g.ElementType,
+				},
+			},
+		}, nil
+	}
+
 	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType)
 
 	if err != nil {
@@ -282,6 +292,11 @@ func (g GeneratorListAttribute) To() (generatorschema.ToFromConversion, error) {
 	}
 
 	return generatorschema.ToFromConversion{
+		Type: &specschema.ElementType{
+			List: &specschema.ListType{
+				ElementType: g.ElementType,
+			},
+		},
 		CollectionType: generatorschema.CollectionFields{
 			GoType: fmt.Sprintf("[]%s", elementGoType),
 		},
@@ -295,6 +310,16 @@ func (g GeneratorListAttribute) From() (generatorschema.ToFromConversion, error)
 		}, nil
 	}
 
+	if generatorschema.HasNestedElementType(g.ElementType) {
+		return generatorschema.ToFromConversion{
+			Type: &specschema.ElementType{
+				List: &specschema.ListType{
+					ElementType: g.ElementType,
+				},
+			},
+		}, nil
+	}
+
 	elementType, err := generatorschema.ElementTypeString(g.ElementType)
 
 	if err != nil {
@@ -302,6 +327,11 @@ func (g GeneratorListAttribute) From() (generatorschema.ToFromConversion, error)
 	}
 
 	return generatorschema.ToFromConversion{
+		Type: &specschema.ElementType{
+			List: &specschema.ListType{
+				ElementType: g.ElementType,
+			},
+		},
 		CollectionType: generatorschema.CollectionFields{
 			ElementType:   elementType,
 			TypeValueFrom: "types.ListValueFrom",
@@ -1378,6 +1378,7 @@ func TestGeneratorListNestedAttribute_Schema(t *testing.T) {
 
 	testCases := map[string]struct {
 		input         GeneratorListNestedAttribute
+		options       generatorschema.SchemaOptions
 		expected      string
 		expectedError error
 	}{
@@ -1606,6 +1607,44 @@ CustomType: my_custom_type,
 },`,
 		},
 
+		"custom-type-omitted": {
+			input: GeneratorListNestedAttribute{
+				CustomType: convert.NewCustomTypeNestedCollection(&specschema.CustomType{
+					Type: "my_custom_type",
+				}),
+				NestedAttributeObject: NewNestedAttributeObject(
+					generatorschema.GeneratorAttributes{
+						"bool": GeneratorBoolAttribute{
+							CustomType: convert.NewCustomTypePrimitive(
+								&specschema.CustomType{
+									Type: "my_bool_custom_type",
+								},
+								nil,
+								"bool",
+							),
+							ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Optional),
+						},
+					},
+					nil,
+					convert.NewPlanModifiers(convert.PlanModifierTypeObject, nil),
+					convert.NewValidators(convert.ValidatorTypeObject, nil),
+					attributeName,
+				),
+			},
+			options: generatorschema.SchemaOptions{
+				OmitCustomTypes: true,
+			},
+			expected: `"list_nested_attribute": schema.ListNestedAttribute{
+NestedObject: schema.NestedAttributeObject{
+Attributes: map[string]schema.Attribute{
+"bool": schema.BoolAttribute{
+Optional: true,
+},
+},
+},
+},`,
+		},
+
 		"required": {
 			input: GeneratorListNestedAttribute{
 				ComputedOptionalRequired: convert.NewComputedOptionalRequired(specschema.Required),
@@ -1916,7 +1955,7 @@ Default: my_list_default.Default(),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.Schema("list_nested_attribute")
+			got, err := testCase.input.Schema("list_nested_attribute", testCase.options)
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -1966,7 +2005,7 @@ func TestGeneratorListNestedAttribute_ModelField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.ModelField("list_nested_attribute")
+			got, err := testCase.input.ModelField("list_nested_attribute", generatorschema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -1991,7 +1991,7 @@ my_other_plan_modifier.Modify(),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.Schema("list_nested_block")
+			got, err := testCase.input.Schema("list_nested_block", generatorschema.SchemaOptions{})
 
 			if err != nil {
 				t.Error(err)
@@ -2041,7 +2041,7 @@ func TestGeneratorListNestedBlock_ModelField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.ModelField("list_nested_block")
+			got, err := testCase.input.ModelField("list_nested_block", generatorschema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
@@ -149,10 +149,10 @@ func (g GeneratorMapAttribute) Equal(ga generatorschema.GeneratorAttribute) bool
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorMapAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
+func (g GeneratorMapAttribute) Schema(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (string, error) {
 	var b bytes.Buffer
 
-	customTypeSchema := g.CustomType.Schema()
+	customTypeSchema := options.CustomTypeSchema(g.CustomType)
 
 	b.WriteString(fmt.Sprintf("%q: schema.MapAttribute{\n", name))
 	b.Write(customTypeSchema)
@@ -171,14 +171,14 @@ func (g GeneratorMapAttribute) Schema(name generatorschema.FrameworkIdentifier)
 	return b.String(), nil
 }
 
-func (g GeneratorMapAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorMapAttribute) ModelField(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (model.Field, error) {
 	field := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: model.MapValueType,
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		field.ValueType = customValueType
@@ -219,7 +219,7 @@ func (g GeneratorMapAttribute) CustomTypeAndValue(name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorMapAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorMapAttribute) ToFromFunctions(name string, typeMapping generatorschema.TypeMapping) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -233,7 +233,7 @@ func (g GeneratorMapAttribute) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromMap(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom)
+	toFrom := generatorschema.NewToFromMap(name, g.AssociatedExternalType, elementTypeType, elementTypeValue, elementFrom, g.ElementType, typeMapping)
 
 	b, err := toFrom.Render()
 
@@ -275,6 +275,16 @@ func (g GeneratorMapAttribute) To() (generatorschema.ToFromConversion, error) {
 		}, nil
 	}
 
+	if generatorschema.HasNestedElementType(g.ElementType) {
+		return generatorschema.ToFromConversion{
+			Type: &specschema.ElementType{
+				Map: &specschema.MapType{
+					ElementType: g.ElementType,
+				},
+			},
+		}, nil
+	}
+
 	elementGoType, err := generatorschema.ElementTypeGoType(g.ElementType)
 
 	if err != nil {
@@ -282,6 +292,11 @@ func (g GeneratorMapAttribute) To() (generatorschema.ToFromConversion, error) {
 	}
 
 	return generatorschema.ToFromConversion{
+		Type: &specschema.ElementType{
+			Map: &specschema.MapType{
+				ElementType: g.ElementType,
+			},
+		},
 		CollectionType: generatorschema.CollectionFields{
 			GoType: fmt.Sprintf("map[string]%s", elementGoType),
 		},
@@ -295,6 +310,16 @@ func (g GeneratorMapAttribute) From() (generatorschema.ToFromConversion, error)
 		}, nil
 	}
 
+	if generatorschema.HasNestedElementType(g.ElementType) {
+		return generatorschema.ToFromConversion{
+			Type: &specschema.ElementType{
+				Map: &specschema.MapType{
+					ElementType: g.ElementType,
+				},
+			},
+		}, nil
+	}
+
 	elementType, err := generatorschema.ElementTypeString(g.ElementType)
 
 	if err != nil {
@@ -302,6 +327,11 @@ func (g GeneratorMapAttribute) From() (generatorschema.ToFromConversion, error)
 	}
 
 	return generatorschema.ToFromConversion{
+		Type: &specschema.ElementType{
+			Map: &specschema.MapType{
+				ElementType: g.ElementType,
+			},
+		},
 		CollectionType: generatorschema.CollectionFields{
 			ElementType:   elementType,
 			TypeValueFrom: "types.MapValueFrom",
@@ -60,10 +60,10 @@ func (n NestedAttributeObject) Imports() *generatorschema.Imports {
 	return imports
 }
 
-func (n NestedAttributeObject) Schema() ([]byte, error) {
+func (n NestedAttributeObject) Schema(options generatorschema.SchemaOptions) ([]byte, error) {
 	var b bytes.Buffer
 
-	attributesSchema, err := n.attributes.Schema()
+	attributesSchema, err := n.attributes.Schema(options)
 
 	if err != nil {
 		return nil, err
@@ -73,7 +73,7 @@ func (n NestedAttributeObject) Schema() ([]byte, error) {
 	b.WriteString("Attributes: map[string]schema.Attribute{")
 	b.WriteString(attributesSchema)
 	b.WriteString("\n},\n")
-	b.Write(n.customType.Schema())
+	b.Write(options.CustomTypeSchema(n.customType))
 	b.Write(n.planModifiers.Schema())
 	b.Write(n.validators.Schema())
 	b.WriteString("},\n")
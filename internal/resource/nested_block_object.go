@@ -68,16 +68,16 @@ func (n NestedBlockObject) Imports() *generatorschema.Imports {
 	return imports
 }
 
-func (n NestedBlockObject) Schema() ([]byte, error) {
+func (n NestedBlockObject) Schema(options generatorschema.SchemaOptions) ([]byte, error) {
 	var b bytes.Buffer
 
-	attributesSchema, err := n.attributes.Schema()
+	attributesSchema, err := n.attributes.Schema(options)
 
 	if err != nil {
 		return nil, err
 	}
 
-	blocksSchema, err := n.blocks.Schema()
+	blocksSchema, err := n.blocks.Schema(options)
 
 	if err != nil {
 		return nil, err
@@ -94,7 +94,7 @@ func (n NestedBlockObject) Schema() ([]byte, error) {
 		b.WriteString(blocksSchema)
 		b.WriteString("\n},\n")
 	}
-	b.Write(n.customType.Schema())
+	b.Write(options.CustomTypeSchema(n.customType))
 	b.Write(n.planModifiers.Schema())
 	b.Write(n.validators.Schema())
 	b.WriteString("},\n")
@@ -156,10 +156,10 @@ func (g GeneratorObjectAttribute) Equal(ga generatorschema.GeneratorAttribute) b
 	return g.Validators.Equal(h.Validators)
 }
 
-func (g GeneratorObjectAttribute) Schema(name generatorschema.FrameworkIdentifier) (string, error) {
+func (g GeneratorObjectAttribute) Schema(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (string, error) {
 	var b bytes.Buffer
 
-	customTypeSchema := g.CustomType.Schema()
+	customTypeSchema := options.CustomTypeSchema(g.CustomType)
 
 	b.WriteString(fmt.Sprintf("%q: schema.ObjectAttribute{\n", name))
 	b.Write(customTypeSchema)
@@ -178,14 +178,14 @@ func (g GeneratorObjectAttribute) Schema(name generatorschema.FrameworkIdentifie
 	return b.String(), nil
 }
 
-func (g GeneratorObjectAttribute) ModelField(name generatorschema.FrameworkIdentifier) (model.Field, error) {
+func (g GeneratorObjectAttribute) ModelField(name generatorschema.FrameworkIdentifier, options generatorschema.SchemaOptions) (model.Field, error) {
 	field := model.Field{
 		Name:      name.ToPascalCase(),
 		TfsdkName: name.ToString(),
 		ValueType: model.ObjectValueType,
 	}
 
-	customValueType := g.CustomType.ValueType()
+	customValueType := options.CustomValueType(g.CustomType)
 
 	if customValueType != "" {
 		field.ValueType = customValueType
@@ -226,7 +226,7 @@ func (g GeneratorObjectAttribute) CustomTypeAndValue(name string) ([]byte, error
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorObjectAttribute) ToFromFunctions(name string) ([]byte, error) {
+func (g GeneratorObjectAttribute) ToFromFunctions(name string, typeMapping generatorschema.TypeMapping) ([]byte, error) {
 	if g.AssociatedExternalType == nil {
 		return nil, nil
 	}
@@ -243,7 +243,7 @@ func (g GeneratorObjectAttribute) ToFromFunctions(name string) ([]byte, error) {
 		return nil, err
 	}
 
-	toFrom := generatorschema.NewToFromObject(name, g.AssociatedExternalType, attrTypesToFuncs, attrTypesFromFuncs)
+	toFrom := generatorschema.NewToFromObject(name, g.AssociatedExternalType, attrTypesToFuncs, attrTypesFromFuncs, g.AttributeTypes, typeMapping)
 
 	b, err := toFrom.Render()
 
@@ -285,6 +285,16 @@ func (g GeneratorObjectAttribute) To() (generatorschema.ToFromConversion, error)
 		}, nil
 	}
 
+	if generatorschema.HasNestedAttributeTypes(g.AttributeTypes) {
+		return generatorschema.ToFromConversion{
+			Type: &specschema.ElementType{
+				Object: &specschema.ObjectType{
+					AttributeTypes: g.AttributeTypes,
+				},
+			},
+		}, nil
+	}
+
 	objectFields := make(map[generatorschema.FrameworkIdentifier]generatorschema.ObjectField, len(g.AttributeTypes))
 
 	for _, v := range g.AttributeTypes {
@@ -298,6 +308,11 @@ func (g GeneratorObjectAttribute) To() (generatorschema.ToFromConversion, error)
 	}
 
 	return generatorschema.ToFromConversion{
+		Type: &specschema.ElementType{
+			Object: &specschema.ObjectType{
+				AttributeTypes: g.AttributeTypes,
+			},
+		},
 		ObjectType: objectFields,
 	}, nil
 }
@@ -309,6 +324,16 @@ func (g GeneratorObjectAttribute) From() (generatorschema.ToFromConversion, erro
 		}, nil
 	}
 
+	if generatorschema.HasNestedAttributeTypes(g.AttributeTypes) {
+		return generatorschema.ToFromConversion{
+			Type: &specschema.ElementType{
+				Object: &specschema.ObjectType{
+					AttributeTypes: g.AttributeTypes,
+				},
+			},
+		}, nil
+	}
+
 	objectFields := make(map[generatorschema.FrameworkIdentifier]generatorschema.ObjectField, len(g.AttributeTypes))
 
 	for _, v := range g.AttributeTypes {
@@ -322,6 +347,11 @@ func (g GeneratorObjectAttribute) From() (generatorschema.ToFromConversion, erro
 	}
 
 	return generatorschema.ToFromConversion{
+		Type: &specschema.ElementType{
+			Object: &specschema.ObjectType{
+				AttributeTypes: g.AttributeTypes,
+			},
+		},
 		ObjectType: objectFields,
 	}, nil
 }
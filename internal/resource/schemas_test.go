@@ -258,7 +258,7 @@ func TestGeneratorResourceSchemas_ModelsBytes(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			g := generatorschema.NewGeneratorSchemas(testCase.input)
+			g := generatorschema.NewGeneratorSchemas(testCase.input, generatorschema.SchemaOptions{})
 			got, err := g.Models()
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
@@ -1257,7 +1257,7 @@ my_other_plan_modifier.Modify(),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.Schema("single_nested_block")
+			got, err := testCase.input.Schema("single_nested_block", generatorschema.SchemaOptions{})
 
 			if err != nil {
 				t.Error(err)
@@ -1308,7 +1308,7 @@ func TestGeneratorSingleNestedBlock_ModelField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := testCase.input.ModelField("single_nested_block")
+			got, err := testCase.input.ModelField("single_nested_block", generatorschema.SchemaOptions{})
 
 			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected error: %s", diff)
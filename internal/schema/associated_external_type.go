@@ -78,8 +78,13 @@ func (a *AssocExtType) Equal(other *AssocExtType) bool {
 	return a.AssociatedExternalType.Equal(other.AssociatedExternalType)
 }
 
+// ToPascalCase returns the type reference as an identifier, such as ApisdkType for apisdk.Type.
+// Slices and maps are spelled out, e.g. ListApisdkType for []apisdk.Type and MapStringListString
+// for map[string][]string, and pointers are omitted.
 func (a *AssocExtType) ToPascalCase() string {
-	inputSplit := strings.Split(a.TypeReference(), ".")
+	typeReference := strings.NewReplacer("[]", "list.", "map[", "map.", "]", ".", "*", "").Replace(a.TypeReference())
+
+	inputSplit := strings.Split(typeReference, ".")
 
 	var ucName string
 
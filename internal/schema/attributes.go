@@ -23,8 +23,12 @@ func (g GeneratorAttributes) AttributeTypes() (map[string]string, error) {
 		switch g[k].GeneratorSchemaType() {
 		case GeneratorBoolAttribute:
 			attributeTypes[k] = "Bool"
+		case GeneratorFloat32Attribute:
+			attributeTypes[k] = "Float32"
 		case GeneratorFloat64Attribute:
 			attributeTypes[k] = "Float64"
+		case GeneratorInt32Attribute:
+			attributeTypes[k] = "Int32"
 		case GeneratorInt64Attribute:
 			attributeTypes[k] = "Int64"
 		case GeneratorListAttribute:
@@ -207,7 +211,7 @@ func (g GeneratorAttributes) Imports() *Imports {
 	return imports
 }
 
-func (g GeneratorAttributes) Schema() (string, error) {
+func (g GeneratorAttributes) Schema(options SchemaOptions) (string, error) {
 	var s strings.Builder
 
 	// Using sorted keys to guarantee attribute order as maps are unordered in Go.
@@ -224,7 +228,7 @@ func (g GeneratorAttributes) Schema() (string, error) {
 			continue
 		}
 
-		str, err := g[k].Schema(FrameworkIdentifier(k))
+		str, err := g[k].Schema(FrameworkIdentifier(k), options)
 
 		if err != nil {
 			return "", err
@@ -4,7 +4,6 @@
 package schema
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 
@@ -30,6 +29,12 @@ func GetAttrTypes(attrTypes specschema.ObjectAttributeTypes) string {
 			} else {
 				aTypes.WriteString("types.BoolType")
 			}
+		case v.Float32 != nil:
+			if v.Float32.CustomType != nil {
+				aTypes.WriteString(v.Float32.CustomType.Type)
+			} else {
+				aTypes.WriteString("types.Float32Type")
+			}
 		case v.Float64 != nil:
 			if v.Float64.CustomType != nil {
 				aTypes.WriteString(v.Float64.CustomType.Type)
@@ -42,6 +47,12 @@ func GetAttrTypes(attrTypes specschema.ObjectAttributeTypes) string {
 			} else {
 				aTypes.WriteString("types.Int64Type")
 			}
+		case v.Int32 != nil:
+			if v.Int32.CustomType != nil {
+				aTypes.WriteString(v.Int32.CustomType.Type)
+			} else {
+				aTypes.WriteString("types.Int32Type")
+			}
 		case v.List != nil:
 			if v.List.CustomType != nil {
 				aTypes.WriteString(fmt.Sprintf("%s{\nElemType: %s,\n}", v.List.CustomType.Type, GetElementType(v.List.ElementType)))
@@ -92,8 +103,9 @@ type AttrTypesToFuncs struct {
 }
 
 // GetAttrTypesToFuncs returns string representations of the function that is used
-// for converting to an API Go type from a framework type.
-// TODO: Handle custom type, and types other than primitives.
+// for converting to an API Go type from a framework type. Collections and objects have
+// no function, as ToFromObject converts objects holding them by generated statements.
+// TODO: Handle custom type.
 func GetAttrTypesToFuncs(a specschema.ObjectAttributeTypes) (map[string]AttrTypesToFuncs, error) {
 	attrTypesFuncs := make(map[string]AttrTypesToFuncs, len(a))
 
@@ -104,6 +116,11 @@ func GetAttrTypesToFuncs(a specschema.ObjectAttributeTypes) (map[string]AttrType
 				AttrValue: "types.Bool",
 				ToFunc:    "ValueBoolPointer",
 			}
+		case v.Float32 != nil:
+			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
+				AttrValue: "types.Float32",
+				ToFunc:    "ValueFloat32Pointer",
+			}
 		case v.Float64 != nil:
 			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
 				AttrValue: "types.Float64",
@@ -114,20 +131,16 @@ func GetAttrTypesToFuncs(a specschema.ObjectAttributeTypes) (map[string]AttrType
 				AttrValue: "types.Int64",
 				ToFunc:    "ValueInt64Pointer",
 			}
-		case v.List != nil:
-			return nil, NewUnimplementedError(errors.New("list attribute type is not yet implemented"))
-		case v.Map != nil:
-			return nil, NewUnimplementedError(errors.New("map attribute type is not yet implemented"))
-
+		case v.Int32 != nil:
+			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
+				AttrValue: "types.Int32",
+				ToFunc:    "ValueInt32Pointer",
+			}
 		case v.Number != nil:
 			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
 				AttrValue: "types.Number",
 				ToFunc:    "ValueBigFloat",
 			}
-		case v.Object != nil:
-			return nil, NewUnimplementedError(errors.New("object attribute type is not yet implemented"))
-		case v.Set != nil:
-			return nil, NewUnimplementedError(errors.New("set attribute type is not yet implemented"))
 		case v.String != nil:
 			attrTypesFuncs[v.Name] = AttrTypesToFuncs{
 				AttrValue: "types.String",
@@ -140,8 +153,9 @@ func GetAttrTypesToFuncs(a specschema.ObjectAttributeTypes) (map[string]AttrType
 }
 
 // GetAttrTypesFromFuncs returns string representations of the function that is used
-// for converting from an API Go type to a framework type.
-// TODO: Handle custom type, and types other than primitives.
+// for converting from an API Go type to a framework type. Collections and objects have
+// no function, as ToFromObject converts objects holding them by generated statements.
+// TODO: Handle custom type.
 func GetAttrTypesFromFuncs(a specschema.ObjectAttributeTypes) (map[string]string, error) {
 	attrTypesFuncs := make(map[string]string, len(a))
 
@@ -149,20 +163,16 @@ func GetAttrTypesFromFuncs(a specschema.ObjectAttributeTypes) (map[string]string
 		switch {
 		case v.Bool != nil:
 			attrTypesFuncs[v.Name] = "types.BoolPointerValue"
+		case v.Float32 != nil:
+			attrTypesFuncs[v.Name] = "types.Float32PointerValue"
 		case v.Float64 != nil:
 			attrTypesFuncs[v.Name] = "types.Float64PointerValue"
 		case v.Int64 != nil:
 			attrTypesFuncs[v.Name] = "types.Int64PointerValue"
-		case v.List != nil:
-			return nil, NewUnimplementedError(errors.New("list attribute type is not yet implemented"))
-		case v.Map != nil:
-			return nil, NewUnimplementedError(errors.New("map attribute type is not yet implemented"))
+		case v.Int32 != nil:
+			attrTypesFuncs[v.Name] = "types.Int32PointerValue"
 		case v.Number != nil:
 			attrTypesFuncs[v.Name] = "types.NumberValue"
-		case v.Object != nil:
-			return nil, NewUnimplementedError(errors.New("object attribute type is not yet implemented"))
-		case v.Set != nil:
-			return nil, NewUnimplementedError(errors.New("set attribute type is not yet implemented"))
 		case v.String != nil:
 			attrTypesFuncs[v.Name] = "types.StringPointerValue"
 		}
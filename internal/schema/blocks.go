@@ -107,8 +107,12 @@ func (g GeneratorBlocks) FromFuncs() map[string]string {
 		switch g[k].GeneratorSchemaType() {
 		case GeneratorBoolAttribute:
 			fromFuncs[k] = "BoolPointerValue"
+		case GeneratorFloat32Attribute:
+			fromFuncs[k] = "Float32PointerValue"
 		case GeneratorFloat64Attribute:
 			fromFuncs[k] = "Float64PointerValue"
+		case GeneratorInt32Attribute:
+			fromFuncs[k] = "Int32PointerValue"
 		case GeneratorInt64Attribute:
 			fromFuncs[k] = "Int64PointerValue"
 		case GeneratorNumberAttribute:
@@ -131,7 +135,7 @@ func (g GeneratorBlocks) Imports() *Imports {
 	return imports
 }
 
-func (g GeneratorBlocks) Schema() (string, error) {
+func (g GeneratorBlocks) Schema(options SchemaOptions) (string, error) {
 	var s strings.Builder
 
 	// Using sorted keys to guarantee block order as maps are unordered in Go.
@@ -148,7 +152,7 @@ func (g GeneratorBlocks) Schema() (string, error) {
 			continue
 		}
 
-		str, err := g[k].Schema(FrameworkIdentifier(k))
+		str, err := g[k].Schema(FrameworkIdentifier(k), options)
 
 		if err != nil {
 			return "", err
@@ -175,8 +179,12 @@ func (g GeneratorBlocks) ToFuncs() map[string]string {
 		switch g[k].GeneratorSchemaType() {
 		case GeneratorBoolAttribute:
 			toFuncs[k] = "ValueBoolPointer"
+		case GeneratorFloat32Attribute:
+			toFuncs[k] = "ValueFloat32Pointer"
 		case GeneratorFloat64Attribute:
 			toFuncs[k] = "ValueFloat64Pointer"
+		case GeneratorInt32Attribute:
+			toFuncs[k] = "ValueInt32Pointer"
 		case GeneratorInt64Attribute:
 			toFuncs[k] = "ValueInt64Pointer"
 		case GeneratorNumberAttribute:
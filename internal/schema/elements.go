@@ -4,7 +4,6 @@
 package schema
 
 import (
-	"errors"
 	"fmt"
 
 	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
@@ -19,6 +18,11 @@ func GetElementType(e specschema.ElementType) string {
 			return e.Bool.CustomType.Type
 		}
 		return "types.BoolType"
+	case e.Float32 != nil:
+		if e.Float32.CustomType != nil {
+			return e.Float32.CustomType.Type
+		}
+		return "types.Float32Type"
 	case e.Float64 != nil:
 		if e.Float64.CustomType != nil {
 			return e.Float64.CustomType.Type
@@ -29,6 +33,11 @@ func GetElementType(e specschema.ElementType) string {
 			return e.Int64.CustomType.Type
 		}
 		return "types.Int64Type"
+	case e.Int32 != nil:
+		if e.Int32.CustomType != nil {
+			return e.Int32.CustomType.Type
+		}
+		return "types.Int32Type"
 	case e.List != nil:
 		if e.List.CustomType != nil {
 			return fmt.Sprintf("%s{\nElemType: %s,\n}", e.List.CustomType.Type, GetElementType(e.List.ElementType))
@@ -73,6 +82,11 @@ func GetElementValueType(e specschema.ElementType) string {
 			return e.Bool.CustomType.ValueType
 		}
 		return "types.Bool"
+	case e.Float32 != nil:
+		if e.Float32.CustomType != nil {
+			return e.Float32.CustomType.ValueType
+		}
+		return "types.Float32"
 	case e.Float64 != nil:
 		if e.Float64.CustomType != nil {
 			return e.Float64.CustomType.ValueType
@@ -83,6 +97,11 @@ func GetElementValueType(e specschema.ElementType) string {
 			return e.Int64.CustomType.ValueType
 		}
 		return "types.Int64"
+	case e.Int32 != nil:
+		if e.Int32.CustomType != nil {
+			return e.Int32.CustomType.ValueType
+		}
+		return "types.Int32"
 	case e.List != nil:
 		if e.List.CustomType != nil {
 			return e.List.CustomType.ValueType
@@ -119,26 +138,23 @@ func GetElementValueType(e specschema.ElementType) string {
 }
 
 // GetElementFromFunc returns a string representation of the function that is used
-// for converting from an API Go type to a framework type.
-// TODO: Handle custom type, and types other than primitives.
+// for converting from an API Go type to a framework type. Collections and objects
+// have no function, as they are converted by generated statements.
+// TODO: Handle custom type.
 func GetElementFromFunc(e specschema.ElementType) (string, error) {
 	switch {
 	case e.Bool != nil:
 		return "types.BoolPointerValue", nil
+	case e.Float32 != nil:
+		return "types.Float32PointerValue", nil
 	case e.Float64 != nil:
 		return "types.Float64PointerValue", nil
 	case e.Int64 != nil:
 		return "types.Int64PointerValue", nil
-	case e.List != nil:
-		return "", NewUnimplementedError(errors.New("list element type is not yet implemented"))
-	case e.Map != nil:
-		return "", NewUnimplementedError(errors.New("map element type is not yet implemented"))
+	case e.Int32 != nil:
+		return "types.Int32PointerValue", nil
 	case e.Number != nil:
 		return "types.NumberValue", nil
-	case e.Object != nil:
-		return "", NewUnimplementedError(errors.New("object element type is not yet implemented"))
-	case e.Set != nil:
-		return "", NewUnimplementedError(errors.New("set element type is not yet implemented"))
 	case e.String != nil:
 		return "types.StringPointerValue", nil
 	}
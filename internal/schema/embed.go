@@ -52,6 +52,51 @@ var BoolValueValueTemplate string
 //go:embed templates/bool_value_valuable.gotmpl
 var BoolValueValuableTemplate string
 
+// Float32 From/To
+
+//go:embed templates/float32_from.gotmpl
+var Float32FromTemplate string
+
+//go:embed templates/float32_to.gotmpl
+var Float32ToTemplate string
+
+// Float32 Type
+
+//go:embed templates/float32_type_equal.gotmpl
+var Float32TypeEqualTemplate string
+
+//go:embed templates/float32_type_string.gotmpl
+var Float32TypeStringTemplate string
+
+//go:embed templates/float32_type_type.gotmpl
+var Float32TypeTypeTemplate string
+
+//go:embed templates/float32_type_typable.gotmpl
+var Float32TypeTypableTemplate string
+
+//go:embed templates/float32_type_value_from_float32.gotmpl
+var Float32TypeValueFromFloat32Template string
+
+//go:embed templates/float32_type_value_from_terraform.gotmpl
+var Float32TypeValueFromTerraformTemplate string
+
+//go:embed templates/float32_type_value_type.gotmpl
+var Float32TypeValueTypeTemplate string
+
+// Float32 Value
+
+//go:embed templates/float32_value_equal.gotmpl
+var Float32ValueEqualTemplate string
+
+//go:embed templates/float32_value_type.gotmpl
+var Float32ValueTypeTemplate string
+
+//go:embed templates/float32_value_value.gotmpl
+var Float32ValueValueTemplate string
+
+//go:embed templates/float32_value_valuable.gotmpl
+var Float32ValueValuableTemplate string
+
 // Float64 From/To
 
 //go:embed templates/float64_from.gotmpl
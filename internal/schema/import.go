@@ -13,8 +13,10 @@ const (
 	ContextImport      = "context"
 	DiagImport         = "github.com/hashicorp/terraform-plugin-framework/diag"
 	FmtImport          = "fmt"
+	MathImport         = "math"
 	MathBigImport      = "math/big"
 	PlanModifierImport = "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	StrconvImport      = "strconv"
 	StringsImport      = "strings"
 	TfTypesImport      = "github.com/hashicorp/terraform-plugin-go/tftypes"
 	TypesImport        = "github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,6 +25,9 @@ type GeneratorSchema struct {
 	Description         *string
 	MarkdownDescription *string
 	DeprecationMessage  *string
+
+	// TypeMapping configures the Go types which the to and from functions of associated external types convert into.
+	TypeMapping TypeMapping
 }
 
 func (g GeneratorSchema) Imports() (string, error) {
@@ -108,6 +111,19 @@ func (g GeneratorSchema) Imports() (string, error) {
 		imports.Add(v.Imports().All()...)
 	}
 
+	// Values converted into the Go types configured by the type mapping can be narrowed, using math, or parsed
+	// and formatted, using strconv. Packages left unused are dropped when the file is assembled.
+	if !g.TypeMapping.IsDefault() {
+		imports.Add([]code.Import{
+			{
+				Path: MathImport,
+			},
+			{
+				Path: StrconvImport,
+			},
+		}...)
+	}
+
 	var sb strings.Builder
 
 	for _, i := range imports.All() {
@@ -123,14 +139,14 @@ func (g GeneratorSchema) Imports() (string, error) {
 	return sb.String(), nil
 }
 
-func (g GeneratorSchema) Schema(name, packageName, generatorType string) ([]byte, error) {
-	attributes, err := g.Attributes.Schema()
+func (g GeneratorSchema) Schema(name, packageName, generatorType string, options SchemaOptions) ([]byte, error) {
+	attributes, err := g.Attributes.Schema(options)
 
 	if err != nil {
 		return nil, err
 	}
 
-	blocks, err := g.Blocks.Schema()
+	blocks, err := g.Blocks.Schema(options)
 
 	if err != nil {
 		return nil, err
@@ -195,7 +211,7 @@ func (g GeneratorSchema) Schema(name, packageName, generatorType string) ([]byte
 	return buf.Bytes(), nil
 }
 
-func (g GeneratorSchema) Models(name string) ([]model.Model, error) {
+func (g GeneratorSchema) Models(name string, options SchemaOptions) ([]model.Model, error) {
 	var models []model.Model
 
 	var modelFields []model.Field
@@ -207,7 +223,7 @@ func (g GeneratorSchema) Models(name string) ([]model.Model, error) {
 			continue
 		}
 
-		modelField, err := g.Attributes[k].ModelField(FrameworkIdentifier(k))
+		modelField, err := g.Attributes[k].ModelField(FrameworkIdentifier(k), options)
 
 		if err != nil {
 			return nil, err
@@ -223,7 +239,7 @@ func (g GeneratorSchema) Models(name string) ([]model.Model, error) {
 			continue
 		}
 
-		modelField, err := g.Blocks[k].ModelField(FrameworkIdentifier(k))
+		modelField, err := g.Blocks[k].ModelField(FrameworkIdentifier(k), options)
 
 		if err != nil {
 			return nil, err
@@ -304,7 +320,7 @@ func (g GeneratorSchema) ToFromFunctions(ctx context.Context, logger *slog.Logge
 		}
 
 		if t, ok := g.Attributes[k].(ToFrom); ok {
-			b, err := t.ToFromFunctions(k)
+			b, err := t.ToFromFunctions(k, g.TypeMapping)
 
 			var unimplErr *UnimplementedError
 
@@ -330,7 +346,7 @@ func (g GeneratorSchema) ToFromFunctions(ctx context.Context, logger *slog.Logge
 		}
 
 		if t, ok := g.Blocks[k].(ToFrom); ok {
-			b, err := t.ToFromFunctions(k)
+			b, err := t.ToFromFunctions(k, g.TypeMapping)
 
 			var unimplErr *UnimplementedError
 
@@ -351,10 +367,14 @@ func ElementTypeString(elementType specschema.ElementType) (string, error) {
 	switch {
 	case elementType.Bool != nil:
 		return "types.BoolType", nil
+	case elementType.Float32 != nil:
+		return "types.Float32Type", nil
 	case elementType.Float64 != nil:
 		return "types.Float64Type", nil
 	case elementType.Int64 != nil:
 		return "types.Int64Type", nil
+	case elementType.Int32 != nil:
+		return "types.Int32Type", nil
 	case elementType.List != nil:
 		elemType, err := ElementTypeString(elementType.List.ElementType)
 		if err != nil {
@@ -389,32 +409,11 @@ func ElementTypeString(elementType specschema.ElementType) (string, error) {
 }
 
 // ElementTypeGoType defaults to the defined pointer types on the basis of the
-// supplied elementType.
-// TODO: Provide a mechanism to allow mapping to be configured. For instance elementType.Float64 => float32
-// TODO: Implement for list, map, object, and set.
+// supplied elementType. Lists and sets are slices, maps are maps with string keys
+// and objects are structs, of the Go types of their elements and attributes.
+// The Go types used by to and from functions are configured by TypeMapping.
 func ElementTypeGoType(elementType specschema.ElementType) (string, error) {
-	switch {
-	case elementType.Bool != nil:
-		return "*bool", nil
-	case elementType.Float64 != nil:
-		return "*float64", nil
-	case elementType.Int64 != nil:
-		return "*int64", nil
-	case elementType.List != nil:
-		return "", NewUnimplementedError(errors.New("list element type is not yet implemented"))
-	case elementType.Map != nil:
-		return "", NewUnimplementedError(errors.New("map element type is not yet implemented"))
-	case elementType.Number != nil:
-		return "*big.Float", nil
-	case elementType.Object != nil:
-		return "", NewUnimplementedError(errors.New("object element type is not yet implemented"))
-	case elementType.Set != nil:
-		return "", NewUnimplementedError(errors.New("set element type is not yet implemented"))
-	case elementType.String != nil:
-		return "*string", nil
-	}
-
-	return "", errors.New("no matching element type found")
+	return TypeMapping{}.GoType(elementType)
 }
 
 func AttrTypesString(attrTypes specschema.ObjectAttributeTypes) (string, error) {
@@ -424,10 +423,14 @@ func AttrTypesString(attrTypes specschema.ObjectAttributeTypes) (string, error)
 		switch {
 		case v.Bool != nil:
 			attrTypesStr = append(attrTypesStr, fmt.Sprintf("%q: types.BoolType", v.Name))
+		case v.Float32 != nil:
+			attrTypesStr = append(attrTypesStr, fmt.Sprintf("%q: types.Float32Type", v.Name))
 		case v.Float64 != nil:
 			attrTypesStr = append(attrTypesStr, fmt.Sprintf("%q: types.Float64Type", v.Name))
 		case v.Int64 != nil:
 			attrTypesStr = append(attrTypesStr, fmt.Sprintf("%q: types.Int64Type", v.Name))
+		case v.Int32 != nil:
+			attrTypesStr = append(attrTypesStr, fmt.Sprintf("%q: types.Int32Type", v.Name))
 		case v.List != nil:
 			elemType, err := ElementTypeString(v.List.ElementType)
 			if err != nil {
@@ -447,7 +450,7 @@ func AttrTypesString(attrTypes specschema.ObjectAttributeTypes) (string, error)
 			if err != nil {
 				return "", err
 			}
-			attrTypesStr = append(attrTypesStr, fmt.Sprintf("%q: types.ObjectType{\nAttrTypes: map[string]attr.Type{\n%s,\n}\n}", v.Name, objAttrTypesStr))
+			attrTypesStr = append(attrTypesStr, fmt.Sprintf("%q: types.ObjectType{\nAttrTypes: map[string]attr.Type{\n%s,\n},\n}", v.Name, objAttrTypesStr))
 		case v.Set != nil:
 			elemType, err := ElementTypeString(v.Set.ElementType)
 			if err != nil {
@@ -462,6 +465,8 @@ func AttrTypesString(attrTypes specschema.ObjectAttributeTypes) (string, error)
 	return strings.Join(attrTypesStr, ",\n"), nil
 }
 
+// ObjectFieldTo returns the field used to convert a primitive object attribute type into its Go type.
+// Objects holding collections or objects are converted through ToFromConversion.Type instead.
 func ObjectFieldTo(o specschema.ObjectAttributeType) (ObjectField, error) {
 	switch {
 	case o.Bool != nil:
@@ -470,6 +475,12 @@ func ObjectFieldTo(o specschema.ObjectAttributeType) (ObjectField, error) {
 			Type:   "types.Bool",
 			ToFunc: "ValueBoolPointer",
 		}, nil
+	case o.Float32 != nil:
+		return ObjectField{
+			GoType: "*float32",
+			Type:   "types.Float32",
+			ToFunc: "ValueFloat32Pointer",
+		}, nil
 	case o.Float64 != nil:
 		return ObjectField{
 			GoType: "*float64",
@@ -482,6 +493,12 @@ func ObjectFieldTo(o specschema.ObjectAttributeType) (ObjectField, error) {
 			Type:   "types.Int64",
 			ToFunc: "ValueInt64Pointer",
 		}, nil
+	case o.Int32 != nil:
+		return ObjectField{
+			GoType: "*int32",
+			Type:   "types.Int32",
+			ToFunc: "ValueInt32Pointer",
+		}, nil
 	case o.List != nil:
 		return ObjectField{}, NewUnimplementedError(errors.New("list attribute type is not yet implemented"))
 	case o.Map != nil:
@@ -507,6 +524,8 @@ func ObjectFieldTo(o specschema.ObjectAttributeType) (ObjectField, error) {
 	return ObjectField{}, errors.New("no matching object attribute type found")
 }
 
+// ObjectFieldFrom returns the field used to convert the Go type of a primitive object attribute type into its value.
+// Objects holding collections or objects are converted through ToFromConversion.Type instead.
 func ObjectFieldFrom(o specschema.ObjectAttributeType) (ObjectField, error) {
 	switch {
 	case o.Bool != nil:
@@ -514,6 +533,11 @@ func ObjectFieldFrom(o specschema.ObjectAttributeType) (ObjectField, error) {
 			Type:     "types.BoolType",
 			FromFunc: "BoolPointerValue",
 		}, nil
+	case o.Float32 != nil:
+		return ObjectField{
+			Type:     "types.Float32Type",
+			FromFunc: "Float32PointerValue",
+		}, nil
 	case o.Float64 != nil:
 		return ObjectField{
 			Type:     "types.Float64Type",
@@ -524,6 +548,11 @@ func ObjectFieldFrom(o specschema.ObjectAttributeType) (ObjectField, error) {
 			Type:     "types.Int64Type",
 			FromFunc: "Int64PointerValue",
 		}, nil
+	case o.Int32 != nil:
+		return ObjectField{
+			Type:     "types.Int32Type",
+			FromFunc: "Int32PointerValue",
+		}, nil
 	case o.List != nil:
 		return ObjectField{}, NewUnimplementedError(errors.New("list attribute type is not yet implemented"))
 	case o.Map != nil:
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+// SchemaOptions configures how the schema is generated.
+type SchemaOptions struct {
+	// OmitCustomTypes generates the schema without CustomType fields, so attributes
+	// and model fields use the framework types. The custom type and value types, and
+	// their to and from functions, are still generated.
+	OmitCustomTypes bool
+}
+
+// CustomTypeSchema returns the CustomType field of the custom type, or nothing
+// when custom types are omitted.
+func (o SchemaOptions) CustomTypeSchema(customType interface{ Schema() []byte }) []byte {
+	if o.OmitCustomTypes {
+		return nil
+	}
+
+	return customType.Schema()
+}
+
+// CustomValueType returns the value type of the custom type, or nothing
+// when custom types are omitted.
+func (o SchemaOptions) CustomValueType(customType interface{ ValueType() string }) string {
+	if o.OmitCustomTypes {
+		return ""
+	}
+
+	return customType.ValueType()
+}
@@ -16,11 +16,13 @@ import (
 // TODO: Field(s) could be added to handle end-user supplying their own templates to allow overriding.
 type GeneratorSchemas struct {
 	schemas map[string]GeneratorSchema
+	options SchemaOptions
 }
 
-func NewGeneratorSchemas(schemas map[string]GeneratorSchema) GeneratorSchemas {
+func NewGeneratorSchemas(schemas map[string]GeneratorSchema, options SchemaOptions) GeneratorSchemas {
 	return GeneratorSchemas{
 		schemas: schemas,
+		options: options,
 	}
 }
 
@@ -34,7 +36,7 @@ func (g GeneratorSchemas) Schemas(packageName, generatorType string) (map[string
 			pkgName = fmt.Sprintf("%s_%s", strings.ToLower(generatorType), k)
 		}
 
-		b, err := s.Schema(k, pkgName, generatorType)
+		b, err := s.Schema(k, pkgName, generatorType, g.options)
 
 		if err != nil {
 			return nil, err
@@ -60,7 +62,7 @@ func (g GeneratorSchemas) Models() (map[string][]byte, error) {
 			DeprecationMessage:  schema.DeprecationMessage,
 		}
 
-		models, err := generatorSchema.Models(name)
+		models, err := generatorSchema.Models(name, g.options)
 		if err != nil {
 			return nil, err
 		}
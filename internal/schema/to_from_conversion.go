@@ -0,0 +1,792 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+)
+
+// conversion generates the statements converting framework values of nested collections and objects
+// to and from Go values. Every element and attribute is converted explicitly, so objects are converted
+// into Go structs without tfsdk tags, which ElementsAs and ValueFrom can not handle.
+//
+// Go types are taken from the Go type of the enclosing value where it declares them, e.g. SubnetSpec
+// for the elements of []SubnetSpec, and default to the types configured by the type mapping otherwise,
+// such as for the fields of a struct.
+type conversion struct {
+	// errReturn is the list of values returned by the generated statements after appending an error diagnostic.
+	errReturn string
+
+	// mapping configures the Go types which are not declared by the enclosing Go type.
+	mapping TypeMapping
+
+	// vars is the number of declared variables, which keeps their names unique.
+	vars int
+}
+
+func newConversion(errReturn string, mapping TypeMapping) *conversion {
+	return &conversion{
+		errReturn: errReturn,
+		mapping:   mapping,
+	}
+}
+
+// HasNestedElementType returns true if the element type is a collection or an object, which is converted
+// by generated statements rather than by a single function call.
+func HasNestedElementType(e specschema.ElementType) bool {
+	return e.List != nil || e.Map != nil || e.Object != nil || e.Set != nil
+}
+
+// HasNestedAttributeTypes returns true if any of the object attribute types is a collection or an object.
+func HasNestedAttributeTypes(attrTypes specschema.ObjectAttributeTypes) bool {
+	for _, v := range attrTypes {
+		if HasNestedElementType(objectAttributeElementType(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// objectAttributeElementType returns the element type holding the type of the object attribute type,
+// so attributes of objects are converted in the same way as elements of collections.
+func objectAttributeElementType(o specschema.ObjectAttributeType) specschema.ElementType {
+	return specschema.ElementType{
+		Bool:    o.Bool,
+		Float32: o.Float32,
+		Float64: o.Float64,
+		Int32:   o.Int32,
+		Int64:   o.Int64,
+		List:    o.List,
+		Map:     o.Map,
+		Number:  o.Number,
+		Object:  o.Object,
+		Set:     o.Set,
+		String:  o.String,
+	}
+}
+
+// elementValueType returns the framework value type of the element type.
+func elementValueType(e specschema.ElementType) (string, error) {
+	switch {
+	case e.Bool != nil:
+		return "types.Bool", nil
+	case e.Float32 != nil:
+		return "types.Float32", nil
+	case e.Float64 != nil:
+		return "types.Float64", nil
+	case e.Int64 != nil:
+		return "types.Int64", nil
+	case e.Int32 != nil:
+		return "types.Int32", nil
+	case e.List != nil:
+		return "types.List", nil
+	case e.Map != nil:
+		return "types.Map", nil
+	case e.Number != nil:
+		return "types.Number", nil
+	case e.Object != nil:
+		return "types.Object", nil
+	case e.Set != nil:
+		return "types.Set", nil
+	case e.String != nil:
+		return "types.String", nil
+	}
+
+	return "", errors.New("no matching element type found")
+}
+
+// collectionElementType returns the type of the elements of the list, map or set element type.
+func collectionElementType(e specschema.ElementType) specschema.ElementType {
+	switch {
+	case e.List != nil:
+		return e.List.ElementType
+	case e.Map != nil:
+		return e.Map.ElementType
+	case e.Set != nil:
+		return e.Set.ElementType
+	}
+
+	return specschema.ElementType{}
+}
+
+// nullValue returns the expression of a null framework value of the collection or object element type.
+func nullValue(e specschema.ElementType) (string, error) {
+	switch {
+	case e.List != nil:
+		elemType, err := ElementTypeString(e.List.ElementType)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("types.ListNull(%s)", elemType), nil
+	case e.Map != nil:
+		elemType, err := ElementTypeString(e.Map.ElementType)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("types.MapNull(%s)", elemType), nil
+	case e.Object != nil:
+		attrTypes, err := AttrTypesString(e.Object.AttributeTypes)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("types.ObjectNull(map[string]attr.Type{\n%s,\n})", attrTypes), nil
+	case e.Set != nil:
+		elemType, err := ElementTypeString(e.Set.ElementType)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("types.SetNull(%s)", elemType), nil
+	}
+
+	return "", errors.New("no matching collection or object element type found")
+}
+
+// convertsByStatements returns true if values of the element type are converted into goType by the statements of conversion,
+// rather than by the templates, which neither convert collections of collections or objects, objects holding collections
+// or objects, nor into Go types other than the ones returned by ElementTypeGoType.
+func convertsByStatements(e specschema.ElementType, goType string) (bool, error) {
+	switch {
+	case e.List != nil || e.Map != nil || e.Set != nil:
+		if HasNestedElementType(collectionElementType(e)) {
+			return true, nil
+		}
+	case e.Object != nil:
+		if HasNestedAttributeTypes(e.Object.AttributeTypes) {
+			return true, nil
+		}
+	}
+
+	defaultGoType, err := ElementTypeGoType(e)
+
+	if err != nil {
+		return false, err
+	}
+
+	return goType != defaultGoType, nil
+}
+
+// convertsElementsByStatements returns true if the elements of a collection of goType, which is found by removing prefix,
+// are collections or objects, or are converted into Go types configured by the type mapping.
+// Otherwise, the collection is converted by ElementsAs and ValueFrom.
+func convertsElementsByStatements(mapping TypeMapping, goType, prefix string, e specschema.ElementType) (bool, error) {
+	if HasNestedElementType(e) {
+		return true, nil
+	}
+
+	if mapping.IsDefault() {
+		return false, nil
+	}
+
+	elemGoType, err := newConversion("", mapping).elementGoType(goType, prefix, e, location{owner: goType})
+
+	if err != nil {
+		return false, err
+	}
+
+	return convertsByStatements(e, elemGoType)
+}
+
+// convertsAttributesByStatements returns true if any attribute of an object of goType is a collection or an object,
+// or is converted into a Go type configured by the type mapping.
+func convertsAttributesByStatements(mapping TypeMapping, goType string, attrTypes specschema.ObjectAttributeTypes) (bool, error) {
+	if HasNestedAttributeTypes(attrTypes) {
+		return true, nil
+	}
+
+	for _, v := range attrTypes {
+		attrType := objectAttributeElementType(v)
+
+		fieldGoType, err := mapping.fieldGoType(location{}.field(goType, v.Name), attrType)
+
+		if err != nil {
+			return false, err
+		}
+
+		byStatements, err := convertsByStatements(attrType, fieldGoType)
+
+		if err != nil {
+			return false, err
+		}
+
+		if byStatements {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// valueMethod returns the method of a framework value of the primitive type returning its Go value.
+func valueMethod(primitive string) string {
+	if primitive == "number" {
+		return "ValueBigFloat"
+	}
+
+	return "Value" + FrameworkIdentifier(primitive).ToPascalCase()
+}
+
+// nativeTo returns the method converting a framework value of the primitive type into goType,
+// if goType is the Go type of the framework value or a pointer to it.
+func nativeTo(primitive, goType string) (string, bool) {
+	base, pointer := strings.CutPrefix(goType, "*")
+
+	if base != primitiveGoTypes[primitive][0] {
+		return "", false
+	}
+
+	if primitive == "number" {
+		return "ValueBigFloat", pointer
+	}
+
+	if pointer {
+		return valueMethod(primitive) + "Pointer", true
+	}
+
+	return valueMethod(primitive), true
+}
+
+// nativeFrom returns the function converting goType into a framework value of the primitive type,
+// if goType is the Go type of the framework value or a pointer to it.
+func nativeFrom(primitive, goType string) (string, bool) {
+	base, pointer := strings.CutPrefix(goType, "*")
+
+	if base != primitiveGoTypes[primitive][0] {
+		return "", false
+	}
+
+	if primitive == "number" {
+		return "types.NumberValue", pointer
+	}
+
+	if pointer {
+		return fmt.Sprintf("types.%sPointerValue", FrameworkIdentifier(primitive).ToPascalCase()), true
+	}
+
+	return fmt.Sprintf("types.%sValue", FrameworkIdentifier(primitive).ToPascalCase()), true
+}
+
+// widen returns the expression converting x, a Go value of the primitive type, into int64 or float64.
+func widen(primitive, x string) string {
+	switch primitive {
+	case "float32":
+		return fmt.Sprintf("float64(%s)", x)
+	case "int32":
+		return fmt.Sprintf("int64(%s)", x)
+	}
+
+	return x
+}
+
+func (c *conversion) name(prefix string) string {
+	c.vars++
+
+	return fmt.Sprintf("%s%d", prefix, c.vars)
+}
+
+// failure returns the statements appending an error diagnostic with the summary and the detail expression, and returning.
+func (c *conversion) failure(summary, detail string) string {
+	return fmt.Sprintf("\ndiags.Append(diag.NewErrorDiagnostic(\n%q,\n%s,\n))\n\nreturn %s\n", summary, detail, c.errReturn)
+}
+
+// assert returns the statements asserting src, an attr.Value, to the framework value type of the element type,
+// and the name of the asserted variable.
+func (c *conversion) assert(e specschema.ElementType, src string) (string, string, error) {
+	valueType, err := elementValueType(e)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	v := c.name("val")
+
+	detail := fmt.Sprintf("fmt.Sprintf(`expected %s, was: %%T`, %s)", valueType, src)
+
+	return fmt.Sprintf("\n%s, ok := %s.(%s)\n\nif !ok {%s}\n", v, src, valueType, c.failure("Value Is Wrong Type", detail)), v, nil
+}
+
+// narrow returns the statements checking that x, an int64 or a float64, fits into the Go type int32 or float32,
+// and the expression of x converted into it.
+func (c *conversion) narrow(x, goType string) (string, string) {
+	n := c.name("num")
+
+	check := fmt.Sprintf("int64(int32(%[1]s)) != %[1]s", n)
+	detail := fmt.Sprintf("fmt.Sprintf(`%%d is out of the range of int32`, %s)", n)
+
+	if goType == "float32" {
+		check = fmt.Sprintf("%[1]s < -math.MaxFloat32 || %[1]s > math.MaxFloat32", n)
+		detail = fmt.Sprintf("fmt.Sprintf(`%%g is out of the range of float32`, %s)", n)
+	}
+
+	return fmt.Sprintf("\n%s := %s\n\nif %s {%s}\n", n, x, check, c.failure("Value Is Out Of Range", detail)), fmt.Sprintf("%s(%s)", goType, n)
+}
+
+// parse returns the statements parsing x, a string, into a Go value of the primitive type, and the expression of the parsed value.
+func (c *conversion) parse(primitive, x string) (string, string, error) {
+	n := c.name("num")
+
+	var parse, v string
+
+	switch primitive {
+	case "bool":
+		parse, v = fmt.Sprintf("strconv.ParseBool(%s)", x), n
+	case "float32":
+		parse, v = fmt.Sprintf("strconv.ParseFloat(%s, 32)", x), fmt.Sprintf("float32(%s)", n)
+	case "float64":
+		parse, v = fmt.Sprintf("strconv.ParseFloat(%s, 64)", x), n
+	case "int32":
+		parse, v = fmt.Sprintf("strconv.ParseInt(%s, 10, 32)", x), fmt.Sprintf("int32(%s)", n)
+	case "int64":
+		parse, v = fmt.Sprintf("strconv.ParseInt(%s, 10, 64)", x), n
+	default:
+		return "", "", fmt.Errorf("%s can not be converted from string", primitive)
+	}
+
+	detail := fmt.Sprintf("fmt.Sprintf(`%%q is not a valid %s: %%s`, %s, err)", primitive, x)
+
+	return fmt.Sprintf("\n%s, err := %s\n\nif err != nil {%s}\n", n, parse, c.failure("Value Is Invalid", detail)), v, nil
+}
+
+// convertTo returns the statements converting x, a Go value of the primitive type, into the Go type base,
+// and the expression of the converted value.
+func (c *conversion) convertTo(primitive, base, x string) (string, string, error) {
+	switch {
+	case base == "string" && primitive == "bool":
+		return "", fmt.Sprintf("strconv.FormatBool(%s)", x), nil
+	case base == "string" && (primitive == "float32" || primitive == "float64"):
+		return "", fmt.Sprintf("strconv.FormatFloat(%s, 'f', -1, %s)", widen(primitive, x), strings.TrimPrefix(primitive, "float")), nil
+	case base == "string" && (primitive == "int32" || primitive == "int64"):
+		return "", fmt.Sprintf("strconv.FormatInt(%s, 10)", widen(primitive, x)), nil
+	case base == "float64" && primitive == "float32", base == "int64" && primitive == "int32":
+		return "", widen(primitive, x), nil
+	case base == "float32" && primitive == "float64", base == "int32" && primitive == "int64":
+		s, v := c.narrow(x, base)
+		return s, v, nil
+	}
+
+	return "", "", fmt.Errorf("%s can not be converted into %s", primitive, base)
+}
+
+// convertFrom returns the statements converting x, a Go value of the Go type base, into a Go value of the primitive type,
+// and the expression of the converted value.
+func (c *conversion) convertFrom(primitive, base, x string) (string, string, error) {
+	switch {
+	case base == "string":
+		return c.parse(primitive, x)
+	case primitive == "float64" && base == "float32", primitive == "int64" && base == "int32":
+		return "", widen(base, x), nil
+	case primitive == "float32" && base == "float64", primitive == "int32" && base == "int64":
+		s, v := c.narrow(x, primitive)
+		return s, v, nil
+	}
+
+	return "", "", fmt.Errorf("%s can not be converted from %s", primitive, base)
+}
+
+// primitiveTo returns the statements assigning the framework value src of the primitive element type to dst, a Go value of goType.
+// Unless goType is the Go type of the framework value or a pointer to it, only known values are converted, leaving dst unchanged otherwise.
+func (c *conversion) primitiveTo(e specschema.ElementType, goType, src, dst string) (string, error) {
+	primitive, err := primitiveType(e)
+
+	if err != nil {
+		return "", err
+	}
+
+	if method, ok := nativeTo(primitive, goType); ok {
+		return fmt.Sprintf("\n%s = %s.%s()\n", dst, src, method), nil
+	}
+
+	base, pointer := strings.CutPrefix(goType, "*")
+
+	s, v, err := c.convertTo(primitive, base, fmt.Sprintf("%s.%s()", src, valueMethod(primitive)))
+
+	if err != nil {
+		return "", err
+	}
+
+	assign := fmt.Sprintf("%s = %s", dst, v)
+
+	if pointer {
+		assign = fmt.Sprintf("%[1]s = new(%[2]s)\n\n*%[1]s = %[3]s", dst, base, v)
+	}
+
+	return fmt.Sprintf("\nif !%[1]s.IsNull() && !%[1]s.IsUnknown() {%[2]s\n%[3]s\n}\n", src, s, assign), nil
+}
+
+// primitiveFrom returns the statements declaring dst, the framework value of the primitive element type converted from src,
+// a Go value of goType. Nil pointers and empty strings are converted into null values.
+func (c *conversion) primitiveFrom(e specschema.ElementType, goType, src, dst string) (string, error) {
+	primitive, err := primitiveType(e)
+
+	if err != nil {
+		return "", err
+	}
+
+	if function, ok := nativeFrom(primitive, goType); ok {
+		return fmt.Sprintf("\n%s := %s(%s)\n", dst, function, src), nil
+	}
+
+	base, pointer := strings.CutPrefix(goType, "*")
+
+	x := src
+
+	var conditions []string
+
+	if pointer {
+		x = "*" + src
+		conditions = append(conditions, fmt.Sprintf("%s != nil", src))
+	}
+
+	if base == "string" {
+		conditions = append(conditions, fmt.Sprintf("%s != \"\"", x))
+	}
+
+	s, v, err := c.convertFrom(primitive, base, x)
+
+	if err != nil {
+		return "", err
+	}
+
+	valueType := FrameworkIdentifier(primitive).ToPascalCase()
+
+	if len(conditions) == 0 {
+		return fmt.Sprintf("%s\n%s := types.%sValue(%s)\n", s, dst, valueType, v), nil
+	}
+
+	return fmt.Sprintf("\n%[1]s := types.%[2]sNull()\n\nif %[3]s {%[4]s\n%[1]s = types.%[2]sValue(%[5]s)\n}\n", dst, valueType, strings.Join(conditions, " && "), s, v), nil
+}
+
+// elementGoType returns the Go type of the elements of a collection of goType at the location, which is found by removing prefix,
+// e.g. "[]" or "map[string]", and otherwise defaults to the Go type of the element type.
+func (c *conversion) elementGoType(goType, prefix string, e specschema.ElementType, l location) (string, error) {
+	if elemGoType, ok := strings.CutPrefix(goType, prefix); ok {
+		return elemGoType, nil
+	}
+
+	return c.mapping.goType(l, e)
+}
+
+// to returns the statements assigning the framework value src of the element type to dst, a Go value of goType at the location.
+// Null and unknown collections and objects leave dst unchanged.
+func (c *conversion) to(e specschema.ElementType, goType, src, dst string, l location) (string, error) {
+	if !HasNestedElementType(e) {
+		return c.primitiveTo(e, goType, src, dst)
+	}
+
+	s, err := c.toKnown(e, goType, src, dst, l)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("\nif !%[1]s.IsNull() && !%[1]s.IsUnknown() {%[2]s}\n", src, s), nil
+}
+
+// toKnown returns the statements assigning the known framework value src of the collection or object element type
+// to dst, a Go value of goType at the location.
+func (c *conversion) toKnown(e specschema.ElementType, goType, src, dst string, l location) (string, error) {
+	var b strings.Builder
+
+	switch {
+	case e.List != nil || e.Set != nil:
+		elemType := collectionElementType(e)
+
+		elemGoType, err := c.elementGoType(goType, "[]", elemType, l)
+
+		if err != nil {
+			return "", err
+		}
+
+		elem := c.name("elem")
+
+		b.WriteString(fmt.Sprintf("\n%s = make(%s, 0, len(%s.Elements()))\n", dst, goType, src))
+		b.WriteString(fmt.Sprintf("\nfor _, %s := range %s.Elements() {", elem, src))
+
+		s, err := c.element(elemType, elemGoType, elem, l, func(v string) string {
+			return fmt.Sprintf("%[1]s = append(%[1]s, %[2]s)", dst, v)
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(s)
+		b.WriteString("}\n")
+	case e.Map != nil:
+		elemGoType, err := c.elementGoType(goType, "map[string]", e.Map.ElementType, l)
+
+		if err != nil {
+			return "", err
+		}
+
+		key, elem := c.name("key"), c.name("elem")
+
+		b.WriteString(fmt.Sprintf("\n%s = make(%s, len(%s.Elements()))\n", dst, goType, src))
+		b.WriteString(fmt.Sprintf("\nfor %s, %s := range %s.Elements() {", key, elem, src))
+
+		s, err := c.element(e.Map.ElementType, elemGoType, elem, l, func(v string) string {
+			return fmt.Sprintf("%s[%s] = %s", dst, key, v)
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(s)
+		b.WriteString("}\n")
+	case e.Object != nil:
+		if structType, ok := strings.CutPrefix(goType, "*"); ok {
+			b.WriteString(fmt.Sprintf("\n%s = &%s{}\n", dst, structType))
+		}
+
+		if len(e.Object.AttributeTypes) == 0 {
+			break
+		}
+
+		attributes := c.name("attributes")
+
+		b.WriteString(fmt.Sprintf("\n%s := %s.Attributes()\n", attributes, src))
+
+		for _, v := range e.Object.AttributeTypes {
+			attrType := objectAttributeElementType(v)
+
+			s, val, err := c.assert(attrType, fmt.Sprintf("%s[%q]", attributes, v.Name))
+
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(s)
+
+			fieldLocation := l.field(goType, v.Name)
+
+			fieldGoType, err := c.mapping.fieldGoType(fieldLocation, attrType)
+
+			if err != nil {
+				return "", err
+			}
+
+			s, err = c.to(attrType, fieldGoType, val, fmt.Sprintf("%s.%s", dst, FrameworkIdentifier(v.Name).ToPascalCase()), fieldLocation)
+
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(s)
+		}
+	default:
+		return "", errors.New("no matching collection or object element type found")
+	}
+
+	return b.String(), nil
+}
+
+// element returns the statements converting elem, an element of a collection at the location, to a Go value of goType,
+// which is stored by the statement returned from store.
+func (c *conversion) element(e specschema.ElementType, goType, elem string, l location, store func(v string) string) (string, error) {
+	var b strings.Builder
+
+	s, val, err := c.assert(e, elem)
+
+	if err != nil {
+		return "", err
+	}
+
+	b.WriteString(s)
+
+	if !HasNestedElementType(e) {
+		primitive, err := primitiveType(e)
+
+		if err != nil {
+			return "", err
+		}
+
+		if method, ok := nativeTo(primitive, goType); ok {
+			b.WriteString(fmt.Sprintf("\n%s\n", store(fmt.Sprintf("%s.%s()", val, method))))
+
+			return b.String(), nil
+		}
+	}
+
+	v := c.name("goVal")
+
+	b.WriteString(fmt.Sprintf("\nvar %s %s\n", v, goType))
+
+	s, err = c.to(e, goType, val, v, l)
+
+	if err != nil {
+		return "", err
+	}
+
+	b.WriteString(s)
+	b.WriteString(fmt.Sprintf("\n%s\n", store(v)))
+
+	return b.String(), nil
+}
+
+// from returns the statements declaring dst, the framework value of the element type converted from src, a Go value of goType
+// at the location. Nil slices, maps and pointers to structs are converted into null values.
+func (c *conversion) from(e specschema.ElementType, goType, src, dst string, l location) (string, error) {
+	if !HasNestedElementType(e) {
+		return c.primitiveFrom(e, goType, src, dst)
+	}
+
+	if e.Object != nil && !strings.HasPrefix(goType, "*") {
+		return c.fromKnown(e, goType, src, dst, l)
+	}
+
+	null, err := nullValue(e)
+
+	if err != nil {
+		return "", err
+	}
+
+	v := c.name("val")
+
+	s, err := c.fromKnown(e, goType, src, v, l)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("\n%[1]s := %[2]s\n\nif %[3]s != nil {%[4]s\n%[1]s = %[5]s\n}\n", dst, null, src, s, v), nil
+}
+
+// fromKnown returns the statements declaring dst, the framework value of the collection or object element type
+// converted from src, a Go value of goType at the location which is not nil.
+func (c *conversion) fromKnown(e specschema.ElementType, goType, src, dst string, l location) (string, error) {
+	var b strings.Builder
+
+	switch {
+	case e.List != nil || e.Set != nil:
+		listElemType := collectionElementType(e)
+		function := "types.ListValue"
+
+		if e.Set != nil {
+			function = "types.SetValue"
+		}
+
+		elemType, err := ElementTypeString(listElemType)
+
+		if err != nil {
+			return "", err
+		}
+
+		elemGoType, err := c.elementGoType(goType, "[]", listElemType, l)
+
+		if err != nil {
+			return "", err
+		}
+
+		elems, elem := c.name("elems"), c.name("elem")
+
+		b.WriteString(fmt.Sprintf("\n%s := make([]attr.Value, 0, len(%s))\n", elems, src))
+		b.WriteString(fmt.Sprintf("\nfor _, %s := range %s {", elem, src))
+
+		s, v, err := c.fromValue(listElemType, elemGoType, elem, l)
+
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(s)
+		b.WriteString(fmt.Sprintf("\n%[1]s = append(%[1]s, %[2]s)\n}\n", elems, v))
+		b.WriteString(fmt.Sprintf("\n%s, d := %s(%s, %s)\n", dst, function, elemType, elems))
+	case e.Map != nil:
+		elemType, err := ElementTypeString(e.Map.ElementType)
+
+		if err != nil {
+			return "", err
+		}
+
+		elemGoType, err := c.elementGoType(goType, "map[string]", e.Map.ElementType, l)
+
+		if err != nil {
+			return "", err
+		}
+
+		elems, key, elem := c.name("elems"), c.name("key"), c.name("elem")
+
+		b.WriteString(fmt.Sprintf("\n%s := make(map[string]attr.Value, len(%s))\n", elems, src))
+		b.WriteString(fmt.Sprintf("\nfor %s, %s := range %s {", key, elem, src))
+
+		s, v, err := c.fromValue(e.Map.ElementType, elemGoType, elem, l)
+
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(s)
+		b.WriteString(fmt.Sprintf("\n%s[%s] = %s\n}\n", elems, key, v))
+		b.WriteString(fmt.Sprintf("\n%s, d := types.MapValue(%s, %s)\n", dst, elemType, elems))
+	case e.Object != nil:
+		attrTypes, err := AttrTypesString(e.Object.AttributeTypes)
+
+		if err != nil {
+			return "", err
+		}
+
+		var attrValues strings.Builder
+
+		for _, v := range e.Object.AttributeTypes {
+			attrType := objectAttributeElementType(v)
+
+			fieldLocation := l.field(goType, v.Name)
+
+			fieldGoType, err := c.mapping.fieldGoType(fieldLocation, attrType)
+
+			if err != nil {
+				return "", err
+			}
+
+			s, val, err := c.fromValue(attrType, fieldGoType, fmt.Sprintf("%s.%s", src, FrameworkIdentifier(v.Name).ToPascalCase()), fieldLocation)
+
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(s)
+
+			attrValues.WriteString(fmt.Sprintf("%q: %s,\n", v.Name, val))
+		}
+
+		b.WriteString(fmt.Sprintf("\n%s, d := types.ObjectValue(map[string]attr.Type{\n%s,\n}, map[string]attr.Value{\n%s})\n", dst, attrTypes, attrValues.String()))
+	default:
+		return "", errors.New("no matching collection or object element type found")
+	}
+
+	b.WriteString(fmt.Sprintf("\ndiags.Append(d...)\n\nif diags.HasError() {\nreturn %s\n}\n", c.errReturn))
+
+	return b.String(), nil
+}
+
+// fromValue returns the statements converting src, a Go value of goType at the location, to a framework value,
+// and the expression of the converted value.
+func (c *conversion) fromValue(e specschema.ElementType, goType, src string, l location) (string, string, error) {
+	if !HasNestedElementType(e) {
+		primitive, err := primitiveType(e)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		if function, ok := nativeFrom(primitive, goType); ok {
+			return "", fmt.Sprintf("%s(%s)", function, src), nil
+		}
+	}
+
+	v := c.name("val")
+
+	s, err := c.from(e, goType, src, v, l)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return s, v, nil
+}
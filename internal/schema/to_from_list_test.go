@@ -20,6 +20,7 @@ func TestToFromList_renderFrom(t *testing.T) {
 		elemTypeType  string
 		elemTypeValue string
 		elemFrom      string
+		elementType   schema.ElementType
 		expected      []byte
 		expectedError error
 	}{
@@ -62,6 +63,87 @@ types.ListUnknown(types.BoolType),
 }, diags
 }
 
+return ExampleValue{
+l,
+}, diags
+}
+`),
+		},
+		"nested": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "example.com/apisdk",
+					},
+					Type: "*[][]string",
+				},
+			},
+			elemTypeType:  "types.ListType{\nElemType: types.StringType,\n}",
+			elemTypeValue: "types.List",
+			elementType: schema.ElementType{
+				List: &schema.ListType{
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+				},
+			},
+			expected: []byte(`
+func (v ExampleValue) FromListListString(ctx context.Context, apiObject *[][]string) (ExampleValue, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+return ExampleValue{
+types.ListNull(types.ListType{
+ElemType: types.StringType,
+}),
+}, diags
+}
+
+elems1 := make([]attr.Value, 0, len(*apiObject))
+
+for _, elem2 := range *apiObject {
+val3 := types.ListNull(types.StringType)
+
+if elem2 != nil {
+elems5 := make([]attr.Value, 0, len(elem2))
+
+for _, elem6 := range elem2 {
+elems5 = append(elems5, types.StringValue(elem6))
+}
+
+val4, d := types.ListValue(types.StringType, elems5)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return ExampleValue{
+types.ListUnknown(types.ListType{
+ElemType: types.StringType,
+}),
+}, diags
+}
+
+val3 = val4
+}
+
+elems1 = append(elems1, val3)
+}
+
+l, d := types.ListValue(types.ListType{
+ElemType: types.StringType,
+}, elems1)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return ExampleValue{
+types.ListUnknown(types.ListType{
+ElemType: types.StringType,
+}),
+}, diags
+}
+
 return ExampleValue{
 l,
 }, diags
@@ -76,7 +158,7 @@ l,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromList := NewToFromList(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromList := NewToFromList(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom, testCase.elementType, TypeMapping{})
 
 			got, err := toFromList.renderFrom()
 
@@ -100,6 +182,7 @@ func TestToFromList_renderTo(t *testing.T) {
 		elemTypeType  string
 		elemTypeValue string
 		elemFrom      string
+		elementType   schema.ElementType
 		expected      []byte
 		expectedError error
 	}{
@@ -143,6 +226,84 @@ return nil, diags
 }
 
 return &apisdkType, diags
+}`),
+		},
+		"nested": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "example.com/apisdk",
+					},
+					Type: "*[][]string",
+				},
+			},
+			elemTypeType:  "types.ListType{\nElemType: types.StringType,\n}",
+			elemTypeValue: "types.List",
+			elementType: schema.ElementType{
+				List: &schema.ListType{
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+				},
+			},
+			expected: []byte(`func (v ExampleValue) ToListListString(ctx context.Context) (*[][]string, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if v.IsNull() {
+return nil, diags
+}
+
+if v.IsUnknown() {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unknown",
+` + "`" + `"ExampleValue" is unknown.` + "`" + `,
+))
+
+return nil, diags
+}
+
+var listListString [][]string
+
+listListString = make([][]string, 0, len(v.Elements()))
+
+for _, elem1 := range v.Elements() {
+val2, ok := elem1.(types.List)
+
+if !ok {
+diags.Append(diag.NewErrorDiagnostic(
+"Value Is Wrong Type",
+fmt.Sprintf(` + "`" + `expected types.List, was: %T` + "`" + `, elem1),
+))
+
+return nil, diags
+}
+
+var goVal3 []string
+
+if !val2.IsNull() && !val2.IsUnknown() {
+goVal3 = make([]string, 0, len(val2.Elements()))
+
+for _, elem4 := range val2.Elements() {
+val5, ok := elem4.(types.String)
+
+if !ok {
+diags.Append(diag.NewErrorDiagnostic(
+"Value Is Wrong Type",
+fmt.Sprintf(` + "`" + `expected types.String, was: %T` + "`" + `, elem4),
+))
+
+return nil, diags
+}
+
+goVal3 = append(goVal3, val5.ValueString())
+}
+}
+
+listListString = append(listListString, goVal3)
+}
+
+return &listListString, diags
 }`),
 		},
 	}
@@ -153,7 +314,7 @@ return &apisdkType, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromList := NewToFromList(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromList := NewToFromList(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom, testCase.elementType, TypeMapping{})
 
 			got, err := toFromList.renderTo()
 
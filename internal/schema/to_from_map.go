@@ -5,7 +5,10 @@ package schema
 
 import (
 	"bytes"
+	"fmt"
 	"text/template"
+
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 )
 
 type ToFromMap struct {
@@ -14,10 +17,12 @@ type ToFromMap struct {
 	ElementTypeType  string
 	ElementTypeValue string
 	ElementFrom      string
+	ElementType      specschema.ElementType
+	TypeMapping      TypeMapping
 	templates        map[string]string
 }
 
-func NewToFromMap(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemFrom string) ToFromMap {
+func NewToFromMap(name string, assocExtType *AssocExtType, elemTypeType, elemTypeValue, elemFrom string, elemType specschema.ElementType, typeMapping TypeMapping) ToFromMap {
 	t := map[string]string{
 		"from": MapFromTemplate,
 		"to":   MapToTemplate,
@@ -29,6 +34,8 @@ func NewToFromMap(name string, assocExtType *AssocExtType, elemTypeType, elemTyp
 		ElementTypeType:  elemTypeType,
 		ElementTypeValue: elemTypeValue,
 		ElementFrom:      elemFrom,
+		ElementType:      elemType,
+		TypeMapping:      typeMapping,
 		templates:        t,
 	}
 }
@@ -65,12 +72,30 @@ func (o ToFromMap) renderTo() ([]byte, error) {
 		return nil, err
 	}
 
+	var statements string
+
+	byStatements, err := convertsElementsByStatements(o.TypeMapping, o.AssocExtType.TypeReference(), "map[string]", o.ElementType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if byStatements {
+		statements, err = newConversion("nil, diags", o.TypeMapping).toKnown(o.collectionType(), o.AssocExtType.TypeReference(), "v", o.AssocExtType.ToCamelCase(), location{owner: o.AssocExtType.TypeReference()})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = t.Execute(&buf, struct {
 		Name         string
 		AssocExtType *AssocExtType
+		Statements   string
 	}{
 		Name:         o.Name.ToPascalCase(),
 		AssocExtType: o.AssocExtType,
+		Statements:   statements,
 	})
 
 	if err != nil {
@@ -89,18 +114,38 @@ func (o ToFromMap) renderFrom() ([]byte, error) {
 		return nil, err
 	}
 
+	var statements string
+
+	byStatements, err := convertsElementsByStatements(o.TypeMapping, o.AssocExtType.TypeReference(), "map[string]", o.ElementType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if byStatements {
+		errReturn := fmt.Sprintf("%sValue{\ntypes.MapUnknown(%s),\n}, diags", o.Name.ToPascalCase(), o.ElementTypeType)
+
+		statements, err = newConversion(errReturn, o.TypeMapping).fromKnown(o.collectionType(), o.AssocExtType.TypeReference(), "*apiObject", "l", location{owner: o.AssocExtType.TypeReference()})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = t.Execute(&buf, struct {
 		Name             string
 		AssocExtType     *AssocExtType
 		ElementTypeType  string
 		ElementTypeValue string
 		ElementFrom      string
+		Statements       string
 	}{
 		Name:             o.Name.ToPascalCase(),
 		AssocExtType:     o.AssocExtType,
 		ElementTypeType:  o.ElementTypeType,
 		ElementTypeValue: o.ElementTypeValue,
 		ElementFrom:      o.ElementFrom,
+		Statements:       statements,
 	})
 
 	if err != nil {
@@ -109,3 +154,12 @@ func (o ToFromMap) renderFrom() ([]byte, error) {
 
 	return buf.Bytes(), nil
 }
+
+// collectionType returns the map type of the elements, which the statements converting nested elements are generated from.
+func (o ToFromMap) collectionType() specschema.ElementType {
+	return specschema.ElementType{
+		Map: &specschema.MapType{
+			ElementType: o.ElementType,
+		},
+	}
+}
@@ -20,6 +20,7 @@ func TestToFromMap_renderFrom(t *testing.T) {
 		elemTypeType  string
 		elemTypeValue string
 		elemFrom      string
+		elementType   schema.ElementType
 		expected      []byte
 		expectedError error
 	}{
@@ -76,7 +77,7 @@ l,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom, testCase.elementType, TypeMapping{})
 
 			got, err := toFromMap.renderFrom()
 
@@ -100,6 +101,7 @@ func TestToFromMap_renderTo(t *testing.T) {
 		elemTypeType  string
 		elemTypeValue string
 		elemFrom      string
+		elementType   schema.ElementType
 		expected      []byte
 		expectedError error
 	}{
@@ -153,7 +155,7 @@ return &apisdkType, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromMap := NewToFromMap(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom, testCase.elementType, TypeMapping{})
 
 			got, err := toFromMap.renderTo()
 
@@ -5,6 +5,9 @@ package schema
 
 import (
 	"bytes"
+	"fmt"
+	"sort"
+	"strings"
 	"text/template"
 )
 
@@ -13,10 +16,11 @@ type ToFromNestedObject struct {
 	AssocExtType *AssocExtType
 	ToFuncs      map[FrameworkIdentifier]ToFromConversion
 	FromFuncs    map[FrameworkIdentifier]ToFromConversion
+	TypeMapping  TypeMapping
 	templates    map[string]string
 }
 
-func NewToFromNestedObject(name string, assocExtType *AssocExtType, toFuncs, fromFuncs map[string]ToFromConversion) ToFromNestedObject {
+func NewToFromNestedObject(name string, assocExtType *AssocExtType, toFuncs, fromFuncs map[string]ToFromConversion, typeMapping TypeMapping) ToFromNestedObject {
 	t := map[string]string{
 		"from": NestedObjectFromTemplate,
 		"to":   NestedObjectToTemplate,
@@ -39,6 +43,7 @@ func NewToFromNestedObject(name string, assocExtType *AssocExtType, toFuncs, fro
 		AssocExtType: assocExtType,
 		FromFuncs:    ff,
 		ToFuncs:      tf,
+		TypeMapping:  typeMapping,
 		templates:    t,
 	}
 }
@@ -75,14 +80,42 @@ func (o ToFromNestedObject) renderTo() ([]byte, error) {
 		return nil, err
 	}
 
+	statements := make(map[FrameworkIdentifier]string)
+
+	c := newConversion("nil, diags", o.TypeMapping)
+
+	for _, k := range sortedKeys(o.ToFuncs) {
+		l, goType, ok, err := o.fieldGoType(k, o.ToFuncs[k])
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		field := fmt.Sprintf("%sField", k.ToCamelCase())
+
+		s, err := c.to(*o.ToFuncs[k].Type, goType, fmt.Sprintf("v.%s", k.ToPrefixPascalCase(o.Name.ToPascalCase())), field, l)
+
+		if err != nil {
+			return nil, err
+		}
+
+		statements[k] = fmt.Sprintf("\nvar %s %s\n%s", field, goType, strings.TrimSuffix(s, "\n"))
+	}
+
 	err = t.Execute(&buf, struct {
 		Name         string
 		AssocExtType *AssocExtType
 		ToFuncs      map[FrameworkIdentifier]ToFromConversion
+		Statements   map[FrameworkIdentifier]string
 	}{
 		Name:         o.Name.ToPascalCase(),
 		AssocExtType: o.AssocExtType,
 		ToFuncs:      o.ToFuncs,
+		Statements:   statements,
 	})
 
 	if err != nil {
@@ -101,14 +134,40 @@ func (o ToFromNestedObject) renderFrom() ([]byte, error) {
 		return nil, err
 	}
 
+	statements := make(map[FrameworkIdentifier]string)
+
+	c := newConversion(fmt.Sprintf("New%sValueUnknown(), diags", o.Name.ToPascalCase()), o.TypeMapping)
+
+	for _, k := range sortedKeys(o.FromFuncs) {
+		l, goType, ok, err := o.fieldGoType(k, o.FromFuncs[k])
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		s, err := c.from(*o.FromFuncs[k].Type, goType, fmt.Sprintf("apiObject.%s", k.ToPascalCase()), fmt.Sprintf("%sVal", k.ToCamelCase()), l)
+
+		if err != nil {
+			return nil, err
+		}
+
+		statements[k] = strings.TrimSuffix(s, "\n")
+	}
+
 	err = t.Execute(&buf, struct {
 		Name         string
 		AssocExtType *AssocExtType
 		FromFuncs    map[FrameworkIdentifier]ToFromConversion
+		Statements   map[FrameworkIdentifier]string
 	}{
 		Name:         o.Name.ToPascalCase(),
 		AssocExtType: o.AssocExtType,
 		FromFuncs:    o.FromFuncs,
+		Statements:   statements,
 	})
 
 	if err != nil {
@@ -117,3 +176,44 @@ func (o ToFromNestedObject) renderFrom() ([]byte, error) {
 
 	return buf.Bytes(), nil
 }
+
+// fieldGoType returns the location and the Go type of the field of the associated external type holding the attribute,
+// and whether the attribute is converted by generated statements rather than by the templates.
+// Attributes with an associated external type of their own are converted by its to and from functions.
+func (o ToFromNestedObject) fieldGoType(k FrameworkIdentifier, conv ToFromConversion) (location, string, bool, error) {
+	if conv.AssocExtType != nil || conv.Type == nil {
+		return location{}, "", false, nil
+	}
+
+	l := location{}.field(o.AssocExtType.TypeReference(), string(k))
+
+	goType, err := o.TypeMapping.fieldGoType(l, *conv.Type)
+
+	if err != nil {
+		return location{}, "", false, err
+	}
+
+	ok, err := convertsByStatements(*conv.Type, goType)
+
+	if err != nil {
+		return location{}, "", false, err
+	}
+
+	return l, goType, ok, nil
+}
+
+// sortedKeys returns the keys of the conversions in the order they are rendered by templates,
+// so the names of variables declared by generated statements are stable.
+func sortedKeys(conversions map[FrameworkIdentifier]ToFromConversion) []FrameworkIdentifier {
+	keys := make([]FrameworkIdentifier, 0, len(conversions))
+
+	for k := range conversions {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
@@ -18,6 +18,7 @@ func TestToFromNestedObject_renderFrom(t *testing.T) {
 		name          string
 		assocExtType  *AssocExtType
 		fromFuncs     map[string]ToFromConversion
+		typeMapping   TypeMapping
 		expected      []byte
 		expectedError error
 	}{
@@ -365,6 +366,157 @@ ExampleType: typeVal,
 state: attr.ValueStateKnown,
 }, diags
 }
+`),
+		},
+		"nested": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Type",
+				},
+			},
+			fromFuncs: map[string]ToFromConversion{
+				"tags": {
+					Type: &schema.ElementType{
+						List: &schema.ListType{
+							ElementType: schema.ElementType{
+								List: &schema.ListType{
+									ElementType: schema.ElementType{
+										String: &schema.StringType{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []byte(`
+func (v ExampleValue) FromApisdkType(ctx context.Context, apiObject *apisdk.Type) (ExampleValue, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+return NewExampleValueNull(), diags
+}
+
+tagsVal := types.ListNull(types.ListType{
+ElemType: types.StringType,
+})
+
+if apiObject.Tags != nil {
+elems2 := make([]attr.Value, 0, len(apiObject.Tags))
+
+for _, elem3 := range apiObject.Tags {
+val4 := types.ListNull(types.StringType)
+
+if elem3 != nil {
+elems6 := make([]attr.Value, 0, len(elem3))
+
+for _, elem7 := range elem3 {
+elems6 = append(elems6, types.StringPointerValue(elem7))
+}
+
+val5, d := types.ListValue(types.StringType, elems6)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return NewExampleValueUnknown(), diags
+}
+
+val4 = val5
+}
+
+elems2 = append(elems2, val4)
+}
+
+val1, d := types.ListValue(types.ListType{
+ElemType: types.StringType,
+}, elems2)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return NewExampleValueUnknown(), diags
+}
+
+tagsVal = val1
+}
+
+return ExampleValue{
+Tags: tagsVal,
+state: attr.ValueStateKnown,
+}, diags
+}
+`),
+		},
+		"type-mapping": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Type",
+				},
+			},
+			fromFuncs: map[string]ToFromConversion{
+				"count": {
+					Default: "Int64PointerValue",
+					Type: &schema.ElementType{
+						Int64: &schema.Int64Type{},
+					},
+				},
+				"size": {
+					Default: "Int64PointerValue",
+					Type: &schema.ElementType{
+						Int64: &schema.Int64Type{},
+					},
+				},
+			},
+			typeMapping: TypeMapping{
+				Defaults: map[string]string{
+					"int64": "*int32",
+				},
+				Attributes: map[string]map[string]string{
+					"apisdk.Type": {
+						"count": "string",
+					},
+				},
+			},
+			expected: []byte(`
+func (v ExampleValue) FromApisdkType(ctx context.Context, apiObject *apisdk.Type) (ExampleValue, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+return NewExampleValueNull(), diags
+}
+
+countVal := types.Int64Null()
+
+if apiObject.Count != "" {
+num1, err := strconv.ParseInt(apiObject.Count, 10, 64)
+
+if err != nil {
+diags.Append(diag.NewErrorDiagnostic(
+"Value Is Invalid",
+fmt.Sprintf(` + "`" + `%q is not a valid int64: %s` + "`" + `, apiObject.Count, err),
+))
+
+return NewExampleValueUnknown(), diags
+}
+
+countVal = types.Int64Value(num1)
+}
+
+sizeVal := types.Int64Null()
+
+if apiObject.Size != nil {
+sizeVal = types.Int64Value(int64(*apiObject.Size))
+}
+
+return ExampleValue{
+Count: countVal,
+Size: sizeVal,
+state: attr.ValueStateKnown,
+}, diags
+}
 `),
 		},
 	}
@@ -375,7 +527,7 @@ state: attr.ValueStateKnown,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromObject := NewToFromNestedObject(testCase.name, testCase.assocExtType, nil, testCase.fromFuncs)
+			toFromObject := NewToFromNestedObject(testCase.name, testCase.assocExtType, nil, testCase.fromFuncs, testCase.typeMapping)
 
 			got, err := toFromObject.renderFrom()
 
@@ -397,6 +549,7 @@ func TestToFromNestedObject_renderTo(t *testing.T) {
 		name          string
 		assocExtType  *AssocExtType
 		toFuncs       map[string]ToFromConversion
+		typeMapping   TypeMapping
 		expected      []byte
 		expectedError error
 	}{
@@ -924,6 +1077,169 @@ Number: typeFieldNumber.ValueBigFloat(),
 String: typeFieldString.ValueStringPointer(),
 },
 }, diags
+}`),
+		},
+		"nested": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Type",
+				},
+			},
+			toFuncs: map[string]ToFromConversion{
+				"tags": {
+					Type: &schema.ElementType{
+						List: &schema.ListType{
+							ElementType: schema.ElementType{
+								List: &schema.ListType{
+									ElementType: schema.ElementType{
+										String: &schema.StringType{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []byte(`func (v ExampleValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if v.IsNull() {
+return nil, diags
+}
+
+if v.IsUnknown() {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unknown",
+` + "`" + `"ExampleValue" is unknown.` + "`" + `,
+))
+
+return nil, diags
+}
+
+var tagsField [][]*string
+
+if !v.Tags.IsNull() && !v.Tags.IsUnknown() {
+tagsField = make([][]*string, 0, len(v.Tags.Elements()))
+
+for _, elem1 := range v.Tags.Elements() {
+val2, ok := elem1.(types.List)
+
+if !ok {
+diags.Append(diag.NewErrorDiagnostic(
+"Value Is Wrong Type",
+fmt.Sprintf(` + "`" + `expected types.List, was: %T` + "`" + `, elem1),
+))
+
+return nil, diags
+}
+
+var goVal3 []*string
+
+if !val2.IsNull() && !val2.IsUnknown() {
+goVal3 = make([]*string, 0, len(val2.Elements()))
+
+for _, elem4 := range val2.Elements() {
+val5, ok := elem4.(types.String)
+
+if !ok {
+diags.Append(diag.NewErrorDiagnostic(
+"Value Is Wrong Type",
+fmt.Sprintf(` + "`" + `expected types.String, was: %T` + "`" + `, elem4),
+))
+
+return nil, diags
+}
+
+goVal3 = append(goVal3, val5.ValueStringPointer())
+}
+}
+
+tagsField = append(tagsField, goVal3)
+}
+}
+
+return &apisdk.Type{
+Tags: tagsField,
+}, diags
+}`),
+		},
+		"type-mapping": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				AssociatedExternalType: &schema.AssociatedExternalType{
+					Type: "*apisdk.Type",
+				},
+			},
+			toFuncs: map[string]ToFromConversion{
+				"count": {
+					Default: "ValueInt64Pointer",
+					Type: &schema.ElementType{
+						Int64: &schema.Int64Type{},
+					},
+				},
+				"size": {
+					Default: "ValueInt64Pointer",
+					Type: &schema.ElementType{
+						Int64: &schema.Int64Type{},
+					},
+				},
+			},
+			typeMapping: TypeMapping{
+				Defaults: map[string]string{
+					"int64": "*int32",
+				},
+				Attributes: map[string]map[string]string{
+					"apisdk.Type": {
+						"count": "string",
+					},
+				},
+			},
+			expected: []byte(`func (v ExampleValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if v.IsNull() {
+return nil, diags
+}
+
+if v.IsUnknown() {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unknown",
+` + "`" + `"ExampleValue" is unknown.` + "`" + `,
+))
+
+return nil, diags
+}
+
+var countField string
+
+if !v.Count.IsNull() && !v.Count.IsUnknown() {
+countField = strconv.FormatInt(v.Count.ValueInt64(), 10)
+}
+
+var sizeField *int32
+
+if !v.Size.IsNull() && !v.Size.IsUnknown() {
+num1 := v.Size.ValueInt64()
+
+if int64(int32(num1)) != num1 {
+diags.Append(diag.NewErrorDiagnostic(
+"Value Is Out Of Range",
+fmt.Sprintf(` + "`" + `%d is out of the range of int32` + "`" + `, num1),
+))
+
+return nil, diags
+}
+
+sizeField = new(int32)
+
+*sizeField = int32(num1)
+}
+
+return &apisdk.Type{
+Count: countField,
+Size: sizeField,
+}, diags
 }`),
 		},
 	}
@@ -934,7 +1250,7 @@ String: typeFieldString.ValueStringPointer(),
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromObject := NewToFromNestedObject(testCase.name, testCase.assocExtType, testCase.toFuncs, nil)
+			toFromObject := NewToFromNestedObject(testCase.name, testCase.assocExtType, testCase.toFuncs, nil, testCase.typeMapping)
 
 			got, err := toFromObject.renderTo()
 
@@ -5,7 +5,10 @@ package schema
 
 import (
 	"bytes"
+	"fmt"
 	"text/template"
+
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
 )
 
 type ToFromObject struct {
@@ -13,10 +16,12 @@ type ToFromObject struct {
 	AssocExtType       *AssocExtType
 	AttrTypesToFuncs   map[FrameworkIdentifier]AttrTypesToFuncs
 	AttrTypesFromFuncs map[FrameworkIdentifier]string
+	AttributeTypes     specschema.ObjectAttributeTypes
+	TypeMapping        TypeMapping
 	templates          map[string]string
 }
 
-func NewToFromObject(name string, assocExtType *AssocExtType, attrTypesToFuncs map[string]AttrTypesToFuncs, attrTypesFromFuncs map[string]string) ToFromObject {
+func NewToFromObject(name string, assocExtType *AssocExtType, attrTypesToFuncs map[string]AttrTypesToFuncs, attrTypesFromFuncs map[string]string, attrTypes specschema.ObjectAttributeTypes, typeMapping TypeMapping) ToFromObject {
 	t := map[string]string{
 		"from": ObjectFromTemplate,
 		"to":   ObjectToTemplate,
@@ -39,6 +44,8 @@ func NewToFromObject(name string, assocExtType *AssocExtType, attrTypesToFuncs m
 		AssocExtType:       assocExtType,
 		AttrTypesToFuncs:   attf,
 		AttrTypesFromFuncs: atff,
+		AttributeTypes:     attrTypes,
+		TypeMapping:        typeMapping,
 		templates:          t,
 	}
 }
@@ -75,14 +82,32 @@ func (o ToFromObject) renderTo() ([]byte, error) {
 		return nil, err
 	}
 
+	var statements string
+
+	byStatements, err := convertsAttributesByStatements(o.TypeMapping, o.AssocExtType.TypeReference(), o.AttributeTypes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if byStatements {
+		statements, err = newConversion("nil, diags", o.TypeMapping).toKnown(o.objectType(), o.AssocExtType.TypeReference(), "v", o.AssocExtType.ToCamelCase(), location{})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = t.Execute(&buf, struct {
 		Name             string
 		AssocExtType     *AssocExtType
 		AttrTypesToFuncs map[FrameworkIdentifier]AttrTypesToFuncs
+		Statements       string
 	}{
 		Name:             o.Name.ToPascalCase(),
 		AssocExtType:     o.AssocExtType,
 		AttrTypesToFuncs: o.AttrTypesToFuncs,
+		Statements:       statements,
 	})
 
 	if err != nil {
@@ -101,14 +126,34 @@ func (o ToFromObject) renderFrom() ([]byte, error) {
 		return nil, err
 	}
 
+	var statements string
+
+	byStatements, err := convertsAttributesByStatements(o.TypeMapping, o.AssocExtType.TypeReference(), o.AttributeTypes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if byStatements {
+		errReturn := fmt.Sprintf("%sValue{\ntypes.ObjectUnknown(v.AttributeTypes(ctx)),\n}, diags", o.Name.ToPascalCase())
+
+		statements, err = newConversion(errReturn, o.TypeMapping).fromKnown(o.objectType(), o.AssocExtType.TypeReference(), "apiObject", "o", location{})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = t.Execute(&buf, struct {
 		Name               string
 		AssocExtType       *AssocExtType
 		AttrTypesFromFuncs map[FrameworkIdentifier]string
+		Statements         string
 	}{
 		Name:               o.Name.ToPascalCase(),
 		AssocExtType:       o.AssocExtType,
 		AttrTypesFromFuncs: o.AttrTypesFromFuncs,
+		Statements:         statements,
 	})
 
 	if err != nil {
@@ -117,3 +162,12 @@ func (o ToFromObject) renderFrom() ([]byte, error) {
 
 	return buf.Bytes(), nil
 }
+
+// objectType returns the object type of the attribute types, which the statements converting nested attributes are generated from.
+func (o ToFromObject) objectType() specschema.ElementType {
+	return specschema.ElementType{
+		Object: &specschema.ObjectType{
+			AttributeTypes: o.AttributeTypes,
+		},
+	}
+}
@@ -18,6 +18,7 @@ func TestToFromObject_renderFrom(t *testing.T) {
 		name               string
 		assocExtType       *AssocExtType
 		attrTypesFromFuncs map[string]string
+		attributeTypes     schema.ObjectAttributeTypes
 		expected           []byte
 		expectedError      error
 	}{
@@ -64,6 +65,83 @@ types.ObjectUnknown(v.AttributeTypes(ctx)),
 }, diags
 }
 
+return ExampleValue{
+o,
+}, diags
+}
+`),
+		},
+		"nested": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "example.com/apisdk",
+					},
+					Type: "*apisdk.Type",
+				},
+			},
+			attrTypesFromFuncs: map[string]string{
+				"tags": "",
+			},
+			attributeTypes: schema.ObjectAttributeTypes{
+				{
+					Name: "tags",
+					List: &schema.ListType{
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+					},
+				},
+			},
+			expected: []byte(`
+func (v ExampleValue) FromApisdkType(ctx context.Context, apiObject *apisdk.Type) (ExampleValue, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if apiObject == nil {
+return ExampleValue{
+types.ObjectNull(v.AttributeTypes(ctx)),
+}, diags
+}
+
+val1 := types.ListNull(types.StringType)
+
+if apiObject.Tags != nil {
+elems3 := make([]attr.Value, 0, len(apiObject.Tags))
+
+for _, elem4 := range apiObject.Tags {
+elems3 = append(elems3, types.StringPointerValue(elem4))
+}
+
+val2, d := types.ListValue(types.StringType, elems3)
+
+diags.Append(d...)
+
+if diags.HasError() {
+return ExampleValue{
+types.ObjectUnknown(v.AttributeTypes(ctx)),
+}, diags
+}
+
+val1 = val2
+}
+
+o, d := types.ObjectValue(map[string]attr.Type{
+"tags": types.ListType{
+ElemType: types.StringType,
+},
+}, map[string]attr.Value{
+"tags": val1,
+})
+
+diags.Append(d...)
+
+if diags.HasError() {
+return ExampleValue{
+types.ObjectUnknown(v.AttributeTypes(ctx)),
+}, diags
+}
+
 return ExampleValue{
 o,
 }, diags
@@ -78,7 +156,7 @@ o,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromObject := NewToFromObject(testCase.name, testCase.assocExtType, nil, testCase.attrTypesFromFuncs)
+			toFromObject := NewToFromObject(testCase.name, testCase.assocExtType, nil, testCase.attrTypesFromFuncs, testCase.attributeTypes, TypeMapping{})
 
 			got, err := toFromObject.renderFrom()
 
@@ -101,6 +179,7 @@ func TestToFromObject_renderTo(t *testing.T) {
 		assocExtType       *AssocExtType
 		attrTypesToFuncs   map[string]AttrTypesToFuncs
 		attrTypesFromFuncs map[string]string
+		attributeTypes     schema.ObjectAttributeTypes
 		expected           []byte
 		expectedError      error
 	}{
@@ -212,6 +291,82 @@ Number: numberAttribute.ValueBigFloat(),
 String: stringAttribute.ValueStringPointer(),
 }
 
+return &apisdkType, diags
+}`),
+		},
+		"nested": {
+			name: "Example",
+			assocExtType: &AssocExtType{
+				&schema.AssociatedExternalType{
+					Import: &code.Import{
+						Path: "example.com/apisdk",
+					},
+					Type: "*apisdk.Type",
+				},
+			},
+			attrTypesToFuncs: map[string]AttrTypesToFuncs{
+				"tags": {},
+			},
+			attributeTypes: schema.ObjectAttributeTypes{
+				{
+					Name: "tags",
+					List: &schema.ListType{
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+					},
+				},
+			},
+			expected: []byte(`func (v ExampleValue) ToApisdkType(ctx context.Context) (*apisdk.Type, diag.Diagnostics) {
+var diags diag.Diagnostics
+
+if v.IsNull() {
+return nil, diags
+}
+
+if v.IsUnknown() {
+diags.Append(diag.NewErrorDiagnostic(
+"ExampleValue Value Is Unknown",
+` + "`" + `"ExampleValue" is unknown.` + "`" + `,
+))
+
+return nil, diags
+}
+
+var apisdkType apisdk.Type
+
+attributes1 := v.Attributes()
+
+val2, ok := attributes1["tags"].(types.List)
+
+if !ok {
+diags.Append(diag.NewErrorDiagnostic(
+"Value Is Wrong Type",
+fmt.Sprintf(` + "`" + `expected types.List, was: %T` + "`" + `, attributes1["tags"]),
+))
+
+return nil, diags
+}
+
+if !val2.IsNull() && !val2.IsUnknown() {
+apisdkType.Tags = make([]*string, 0, len(val2.Elements()))
+
+for _, elem3 := range val2.Elements() {
+val4, ok := elem3.(types.String)
+
+if !ok {
+diags.Append(diag.NewErrorDiagnostic(
+"Value Is Wrong Type",
+fmt.Sprintf(` + "`" + `expected types.String, was: %T` + "`" + `, elem3),
+))
+
+return nil, diags
+}
+
+apisdkType.Tags = append(apisdkType.Tags, val4.ValueStringPointer())
+}
+}
+
 return &apisdkType, diags
 }`),
 		},
@@ -223,7 +378,7 @@ return &apisdkType, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromObject := NewToFromObject(testCase.name, testCase.assocExtType, testCase.attrTypesToFuncs, nil)
+			toFromObject := NewToFromObject(testCase.name, testCase.assocExtType, testCase.attrTypesToFuncs, nil, testCase.attributeTypes, TypeMapping{})
 
 			got, err := toFromObject.renderTo()
 
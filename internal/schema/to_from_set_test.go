@@ -20,6 +20,7 @@ func TestToFromSet_renderFrom(t *testing.T) {
 		elemTypeType  string
 		elemTypeValue string
 		elemFrom      string
+		elementType   schema.ElementType
 		expected      []byte
 		expectedError error
 	}{
@@ -76,7 +77,7 @@ l,
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromSet := NewToFromSet(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromSet := NewToFromSet(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom, testCase.elementType, TypeMapping{})
 
 			got, err := toFromSet.renderFrom()
 
@@ -100,6 +101,7 @@ func TestToFromSet_renderTo(t *testing.T) {
 		elemTypeType  string
 		elemTypeValue string
 		elemFrom      string
+		elementType   schema.ElementType
 		expected      []byte
 		expectedError error
 	}{
@@ -153,7 +155,7 @@ return &apisdkType, diags
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			toFromSet := NewToFromSet(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom)
+			toFromSet := NewToFromSet(testCase.name, testCase.assocExtType, testCase.elemTypeType, testCase.elemTypeValue, testCase.elemFrom, testCase.elementType, TypeMapping{})
 
 			got, err := toFromSet.renderTo()
 
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	specschema "github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+)
+
+// TypeMapping configures the Go types which values are converted into by the to and from functions
+// of associated external types. Without it, values are converted into the types returned by ElementTypeGoType.
+type TypeMapping struct {
+	// Defaults maps primitive types, e.g. "int64", to the Go type of every value of the type, e.g. "*int32".
+	Defaults map[string]string
+
+	// Attributes maps associated external types, e.g. "apisdk.Cluster", to the Go types of their attributes
+	// by path, e.g. "spec.size" for the attribute "size" of the object "spec", overriding Defaults.
+	// Collections and objects are mapped by their whole Go type, e.g. "[]*int32".
+	Attributes map[string]map[string]string
+}
+
+// primitiveGoTypes lists the Go types, without pointer, which values of each primitive type can be converted into.
+// The first one is the type of the framework value, which is converted into a pointer by default.
+var primitiveGoTypes = map[string][]string{
+	"bool":    {"bool", "string"},
+	"float32": {"float32", "float64", "string"},
+	"float64": {"float64", "float32", "string"},
+	"int32":   {"int32", "int64", "string"},
+	"int64":   {"int64", "int32", "string"},
+	"number":  {"big.Float"},
+	"string":  {"string"},
+}
+
+// NewTypeMapping returns the type mapping of the defaults and attributes, or an error if it is not valid.
+func NewTypeMapping(defaults map[string]string, attributes map[string]map[string]string) (TypeMapping, error) {
+	m := TypeMapping{
+		Defaults:   defaults,
+		Attributes: attributes,
+	}
+
+	if err := m.Validate(); err != nil {
+		return TypeMapping{}, err
+	}
+
+	return m, nil
+}
+
+// Validate returns an error if Defaults maps a type which is not primitive, or to a Go type its values can not be converted into.
+func (m TypeMapping) Validate() error {
+	keys := make([]string, 0, len(m.Defaults))
+
+	for k := range m.Defaults {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if _, ok := primitiveGoTypes[k]; !ok {
+			return fmt.Errorf("type mapping: %q is not a primitive type", k)
+		}
+
+		if err := validatePrimitiveGoType(k, m.Defaults[k]); err != nil {
+			return fmt.Errorf("type mapping: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// IsDefault returns true if the type mapping does not change any Go type.
+func (m TypeMapping) IsDefault() bool {
+	return len(m.Defaults) == 0 && len(m.Attributes) == 0
+}
+
+// validatePrimitiveGoType returns an error if values of the primitive type can not be converted into goType.
+// Numbers are only converted into *big.Float.
+func validatePrimitiveGoType(primitive, goType string) error {
+	base, pointer := strings.CutPrefix(goType, "*")
+
+	if !slices.Contains(primitiveGoTypes[primitive], base) || (primitive == "number" && !pointer) {
+		return fmt.Errorf("%s can not be converted into %s", primitive, goType)
+	}
+
+	return nil
+}
+
+// primitiveType returns the name of the primitive element type, as used by Defaults.
+func primitiveType(e specschema.ElementType) (string, error) {
+	switch {
+	case e.Bool != nil:
+		return "bool", nil
+	case e.Float32 != nil:
+		return "float32", nil
+	case e.Float64 != nil:
+		return "float64", nil
+	case e.Int32 != nil:
+		return "int32", nil
+	case e.Int64 != nil:
+		return "int64", nil
+	case e.Number != nil:
+		return "number", nil
+	case e.String != nil:
+		return "string", nil
+	}
+
+	return "", errors.New("no matching primitive element type found")
+}
+
+// location is the associated external type holding a converted value, and the path of the value within it,
+// which the Go type of the value is overridden by.
+type location struct {
+	owner string
+	path  string
+}
+
+// field returns the location of the attribute of an object converted into goType. The attributes of structs
+// declared by the Go type of the enclosing value extend its path, while named types start a new path.
+func (l location) field(goType, name string) location {
+	structType := strings.TrimPrefix(goType, "*")
+
+	if !strings.HasPrefix(structType, "struct") {
+		return location{
+			owner: structType,
+			path:  name,
+		}
+	}
+
+	if l.path == "" {
+		return location{
+			owner: l.owner,
+			path:  name,
+		}
+	}
+
+	return location{
+		owner: l.owner,
+		path:  l.path + "." + name,
+	}
+}
+
+// GoType returns the Go type of values of the element type. Lists and sets are slices, maps are maps with string keys
+// and objects are structs, of the Go types of their elements and attributes.
+func (m TypeMapping) GoType(e specschema.ElementType) (string, error) {
+	return m.goType(location{}, e)
+}
+
+// fieldGoType returns the Go type of the value at the location, which is overridden by Attributes.
+func (m TypeMapping) fieldGoType(l location, e specschema.ElementType) (string, error) {
+	if goType, ok := m.Attributes[l.owner][l.path]; ok && l.path != "" {
+		return goType, nil
+	}
+
+	return m.goType(l, e)
+}
+
+// goType returns the Go type of values of the element type at the location, where attributes of objects can be overridden.
+func (m TypeMapping) goType(l location, e specschema.ElementType) (string, error) {
+	switch {
+	case e.List != nil:
+		elemGoType, err := m.goType(l, e.List.ElementType)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elemGoType, nil
+	case e.Map != nil:
+		elemGoType, err := m.goType(l, e.Map.ElementType)
+		if err != nil {
+			return "", err
+		}
+		return "map[string]" + elemGoType, nil
+	case e.Object != nil:
+		return m.objectGoType(l, e.Object.AttributeTypes)
+	case e.Set != nil:
+		elemGoType, err := m.goType(l, e.Set.ElementType)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elemGoType, nil
+	}
+
+	primitive, err := primitiveType(e)
+
+	if err != nil {
+		return "", err
+	}
+
+	if goType, ok := m.Defaults[primitive]; ok {
+		return goType, nil
+	}
+
+	return "*" + primitiveGoTypes[primitive][0], nil
+}
+
+// objectGoType returns the Go struct type of the object attribute types at the location, with a field for each attribute.
+func (m TypeMapping) objectGoType(l location, attrTypes specschema.ObjectAttributeTypes) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("struct {\n")
+
+	for _, v := range attrTypes {
+		goType, err := m.fieldGoType(l.field("struct", v.Name), objectAttributeElementType(v))
+
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(fmt.Sprintf("%s %s\n", FrameworkIdentifier(v.Name).ToPascalCase(), goType))
+	}
+
+	b.WriteString("}")
+
+	return b.String(), nil
+}
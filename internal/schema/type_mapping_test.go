@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/schema"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTypeMapping_GoType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeMapping   TypeMapping
+		elementType   schema.ElementType
+		expected      string
+		expectedError error
+	}{
+		"default": {
+			elementType: schema.ElementType{
+				Int64: &schema.Int64Type{},
+			},
+			expected: "*int64",
+		},
+		"defaults": {
+			typeMapping: TypeMapping{
+				Defaults: map[string]string{
+					"int64": "*int32",
+				},
+			},
+			elementType: schema.ElementType{
+				Int64: &schema.Int64Type{},
+			},
+			expected: "*int32",
+		},
+		"collection": {
+			typeMapping: TypeMapping{
+				Defaults: map[string]string{
+					"string": "string",
+				},
+			},
+			elementType: schema.ElementType{
+				Map: &schema.MapType{
+					ElementType: schema.ElementType{
+						List: &schema.ListType{
+							ElementType: schema.ElementType{
+								String: &schema.StringType{},
+							},
+						},
+					},
+				},
+			},
+			expected: "map[string][]string",
+		},
+		"object": {
+			typeMapping: TypeMapping{
+				Defaults: map[string]string{
+					"int64": "*int32",
+				},
+			},
+			elementType: schema.ElementType{
+				Object: &schema.ObjectType{
+					AttributeTypes: schema.ObjectAttributeTypes{
+						{
+							Name:  "size",
+							Int64: &schema.Int64Type{},
+						},
+						{
+							Name:   "name",
+							String: &schema.StringType{},
+						},
+					},
+				},
+			},
+			expected: "struct {\nSize *int32\nName *string\n}",
+		},
+		"unknown": {
+			elementType:   schema.ElementType{},
+			expectedError: errors.New("no matching primitive element type found"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typeMapping.GoType(testCase.elementType)
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTypeMapping_fieldGoType(t *testing.T) {
+	t.Parallel()
+
+	typeMapping := TypeMapping{
+		Attributes: map[string]map[string]string{
+			"apisdk.Cluster": {
+				"name":      "string",
+				"spec.size": "*string",
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		location    location
+		elementType schema.ElementType
+		expected    string
+	}{
+		"attribute": {
+			location: location{}.field("*apisdk.Cluster", "name"),
+			elementType: schema.ElementType{
+				String: &schema.StringType{},
+			},
+			expected: "string",
+		},
+		"nested-attribute": {
+			location: location{}.field("*apisdk.Cluster", "spec").field("struct {\nSize *int64\n}", "size"),
+			elementType: schema.ElementType{
+				Int64: &schema.Int64Type{},
+			},
+			expected: "*string",
+		},
+		"named-type": {
+			location: location{}.field("*apisdk.Cluster", "spec").field("apisdk.Spec", "size"),
+			elementType: schema.ElementType{
+				Int64: &schema.Int64Type{},
+			},
+			expected: "*int64",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := typeMapping.fieldGoType(testCase.location, testCase.elementType)
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTypeMapping_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeMapping   TypeMapping
+		expectedError error
+	}{
+		"valid": {
+			typeMapping: TypeMapping{
+				Defaults: map[string]string{
+					"bool":    "bool",
+					"float64": "*float32",
+					"int64":   "string",
+					"number":  "*big.Float",
+				},
+			},
+		},
+		"not-primitive": {
+			typeMapping: TypeMapping{
+				Defaults: map[string]string{
+					"list": "[]string",
+				},
+			},
+			expectedError: errors.New(`type mapping: "list" is not a primitive type`),
+		},
+		"not-convertible": {
+			typeMapping: TypeMapping{
+				Defaults: map[string]string{
+					"string": "*int64",
+				},
+			},
+			expectedError: errors.New("type mapping: string can not be converted into *int64"),
+		},
+		"number-value": {
+			typeMapping: TypeMapping{
+				Defaults: map[string]string{
+					"number": "big.Float",
+				},
+			},
+			expectedError: errors.New("type mapping: number can not be converted into big.Float"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.typeMapping.Validate()
+
+			if diff := cmp.Diff(err, testCase.expectedError, equateErrorMessage); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+		})
+	}
+}
@@ -34,8 +34,8 @@ type GeneratorAttribute interface {
 	Equal(GeneratorAttribute) bool
 	GeneratorSchemaType() Type
 	Imports() *Imports
-	ModelField(FrameworkIdentifier) (model.Field, error)
-	Schema(FrameworkIdentifier) (string, error)
+	ModelField(FrameworkIdentifier, SchemaOptions) (model.Field, error)
+	Schema(FrameworkIdentifier, SchemaOptions) (string, error)
 }
 
 type AttrType interface {
@@ -54,12 +54,12 @@ type GeneratorBlock interface {
 	Equal(GeneratorBlock) bool
 	GeneratorSchemaType() Type
 	Imports() *Imports
-	ModelField(FrameworkIdentifier) (model.Field, error)
-	Schema(FrameworkIdentifier) (string, error)
+	ModelField(FrameworkIdentifier, SchemaOptions) (model.Field, error)
+	Schema(FrameworkIdentifier, SchemaOptions) (string, error)
 }
 
 type ToFrom interface {
-	ToFromFunctions(name string) ([]byte, error)
+	ToFromFunctions(name string, typeMapping TypeMapping) ([]byte, error)
 }
 
 type ToFromConversion struct {
@@ -67,6 +67,10 @@ type ToFromConversion struct {
 	AssocExtType   *AssocExtType
 	CollectionType CollectionFields
 	ObjectType     map[FrameworkIdentifier]ObjectField
+
+	// Type is the schema type of the attribute. Collections of collections or objects, objects holding collections
+	// or objects, and attributes converted into Go types configured by a TypeMapping are converted by generated statements.
+	Type *specschema.ElementType
 }
 
 type CollectionFields struct {
@@ -111,4 +115,5 @@ const (
 	GeneratorSingleNestedAttribute
 	GeneratorSingleNestedBlock
 	GeneratorStringAttribute
+	GeneratorFloat32Attribute
 )
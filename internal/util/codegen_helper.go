@@ -153,22 +153,6 @@ func camelToSnake(s string) string {
 	return result.String()
 }
 
-func MakeIdGetter(target string) string {
-	s := "response"
-	parts := strings.Split(target, ".")
-
-	for idx, val := range parts {
-		if idx == len(parts)-1 {
-			s = s + fmt.Sprintf(`["%s"].(string)`, ToCamelCase(val))
-			continue
-		}
-
-		s = s + fmt.Sprintf(`["%s"].(map[string]interface{})`, ToCamelCase(val))
-	}
-
-	return s
-}
-
 func ClearDoubleQuote(s string) string {
 	return strings.Replace(strings.Replace(strings.Replace(s, "\\", "", -1), "\"", "", -1), `"`, "", -1)
 }
@@ -1,6 +1,9 @@
 package util
 
 import (
+	"encoding/json"
+	"strings"
+
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/datasource"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/provider"
 	"github.com/NaverCloudPlatform/terraform-plugin-codegen-spec/resource"
@@ -14,15 +17,24 @@ type OptionalRequestBody struct {
 }
 
 type RequestParametersInfo struct {
-	Name   string `json:"name,omitempty"`
-	Type   string `json:"type,omitempty"`
-	Format string `json:"format,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Format  string `json:"format,omitempty"`
+	Example any    `json:"example,omitempty"`
 }
 
 type NcloudCommonRequestType struct {
 	DetailedRequestType
-	Method string `json:"method,omitempty"`
-	Path   string `json:"path,omitempty"`
+	Method   string    `json:"method,omitempty"`
+	Path     string    `json:"path,omitempty"`
+	NotFound *NotFound `json:"not_found,omitempty"`
+}
+
+// NotFound describes how an API reports that the requested object does not exist.
+type NotFound struct {
+	StatusCodes []int    `json:"status_codes,omitempty"`
+	ErrorCodes  []string `json:"error_codes,omitempty"`
+	EmptyBody   bool     `json:"empty_body,omitempty"`
 }
 
 type CrudParameters struct {
@@ -52,6 +64,20 @@ type NcloudRequestBody struct {
 type NcloudProvider struct {
 	provider.Provider
 	Endpoint string `json:"endpoint,omitempty"`
+
+	// Endpoints maps sites ("public", "fin" or "gov") and regions to the endpoint of the service.
+	// The "default" region is used for regions which are not listed, and Endpoint for sites which are not listed.
+	Endpoints map[string]map[string]string `json:"endpoints,omitempty"`
+
+	// TypeMapping configures the Go types which the to and from functions of associated external types convert into.
+	TypeMapping *TypeMapping `json:"type_mapping,omitempty"`
+}
+
+// TypeMapping maps primitive types, e.g. "int64", to Go types, e.g. "*int32", by default, and attributes of
+// associated external types, e.g. "apisdk.Cluster", by path, e.g. "spec.size", to Go types overriding the defaults.
+type TypeMapping struct {
+	Defaults   map[string]string            `json:"defaults,omitempty"`
+	Attributes map[string]map[string]string `json:"attributes,omitempty"`
 }
 
 type NcloudSpecification struct {
@@ -63,18 +89,83 @@ type NcloudSpecification struct {
 
 type Resource struct {
 	resource.Resource
-	CRUDParameters      CrudParameters `json:"crud_parameters"`
-	RefreshObjectName   string         `json:"refresh_object_name"`
-	ImportStateOverride string         `json:"import_state_override"`
-	Id                  string         `json:"id"`
+	CRUDParameters      CrudParameters       `json:"crud_parameters"`
+	RefreshObjectName   string               `json:"refresh_object_name"`
+	ImportStateOverride *ImportStateOverride `json:"import_state_override,omitempty"`
+	Id                  string               `json:"id"`
+	PathParameters      map[string]string    `json:"path_parameters,omitempty"`
+	Wait                *WaitConfig          `json:"wait,omitempty"`
+	Timeouts            *Timeouts            `json:"timeouts,omitempty"`
+}
+
+// ImportStateOverride describes how the import ID is split into the attributes identifying the remote object.
+// It is written either as an object with a separator and typed parts, or as dot separated parameter names, e.g. "product-id.api-id".
+type ImportStateOverride struct {
+	Separator string             `json:"separator,omitempty"`
+	Parts     []*ImportStatePart `json:"parts,omitempty"`
+}
+
+// ImportStatePart is a part of the import ID, set into the attribute of the same name.
+type ImportStatePart struct {
+	Name string `json:"name"`
+
+	// Type is either "string" or "int64". Empty means "string".
+	Type string `json:"type,omitempty"`
+}
+
+// UnmarshalJSON accepts the object form as well as the dot separated form.
+// A single parameter name in the dot separated form means the import ID is used as is.
+func (o *ImportStateOverride) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*o = ImportStateOverride{}
+
+		names := strings.Split(s, ".")
+		if len(names) < 2 {
+			return nil
+		}
+
+		o.Separator = "."
+		for _, name := range names {
+			o.Parts = append(o.Parts, &ImportStatePart{Name: ToLowerCase(PathToPascal(name))})
+		}
+
+		return nil
+	}
+
+	type importStateOverride ImportStateOverride
+
+	return json.Unmarshal(b, (*importStateOverride)(o))
+}
+
+// Timeouts holds the default timeout of each operation as a duration string, e.g. "30m".
+type Timeouts struct {
+	Create string `json:"create,omitempty"`
+	Update string `json:"update,omitempty"`
+	Delete string `json:"delete,omitempty"`
+}
+
+// WaitConfig describes how to wait for asynchronous operations by polling a status attribute of the read response.
+type WaitConfig struct {
+	StatusPath string      `json:"status_path"`
+	Create     *WaitStates `json:"create,omitempty"`
+	Update     *WaitStates `json:"update,omitempty"`
+	Delete     *WaitStates `json:"delete,omitempty"`
+}
+
+type WaitStates struct {
+	Pending []string `json:"pending,omitempty"`
+	Target  []string `json:"target,omitempty"`
+	Failure []string `json:"failure,omitempty"`
 }
 
 type DataSource struct {
 	datasource.DataSource
-	CRUDParameters      CrudParameters `json:"crud_parameters"`
-	RefreshObjectName   string         `json:"refresh_object_name"`
-	ImportStateOverride string         `json:"import_state_override"`
-	Id                  string         `json:"id"`
+	CRUDParameters      CrudParameters       `json:"crud_parameters"`
+	RefreshObjectName   string               `json:"refresh_object_name"`
+	ImportStateOverride *ImportStateOverride `json:"import_state_override,omitempty"`
+	Id                  string               `json:"id"`
+	PathParameters      map[string]string    `json:"path_parameters,omitempty"`
 }
 
 type Schema struct {